@@ -1,13 +1,15 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"delivery/cmd"
 	httpadapter "delivery/internal/adapters/in/http"
-	"delivery/internal/adapters/out/postgres/courierrepo"
-	"delivery/internal/adapters/out/postgres/orderrepo"
+	kafkain "delivery/internal/adapters/in/kafka"
+	"delivery/internal/adapters/out/postgres/migrations"
 	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
+	"expvar"
 	"fmt"
 	"net/http"
 	"os"
@@ -16,7 +18,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/labstack/gommon/log"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/robfig/cron/v3"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -37,17 +39,28 @@ func main() {
 		log.Fatal(err.Error())
 	}
 
-	createDBIfNotExists(
-		config.DbHost,
-		config.DbPort,
-		config.DbUser,
-		config.DbPassword,
-		config.DbName,
-		config.DbSslMode,
-	)
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrateCommand(config, connectionString, os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "dlq" {
+		runDeadLetterCommand(config, os.Args[2:])
+		return
+	}
+
+	if config.DbMigrationsMode == migrationsModeAuto {
+		createDBIfNotExists(
+			config.DbHost,
+			config.DbPort,
+			config.DbUser,
+			config.DbPassword,
+			config.DbName,
+			config.DbSslMode,
+		)
+	}
 
 	gormDB := mustGormOpen(connectionString)
-	mustAutoMigrate(gormDB)
+	mustPrepareSchema(gormDB, config.DbMigrationsMode)
 
 	compositionRoot := cmd.NewCompositionRoot(
 		config,
@@ -57,23 +70,53 @@ func main() {
 
 	startCronJobs(compositionRoot)
 	startBasketConfirmedConsumer(compositionRoot)
+	startBasketCancelledConsumer(compositionRoot)
 	startWebServer(compositionRoot, config.HttpPort)
 }
 
 func getConfigs() cmd.Config {
 	config := cmd.Config{
-		HttpPort:                  goDotEnvVariable("HTTP_PORT"),
-		DbHost:                    goDotEnvVariable("DB_HOST"),
-		DbPort:                    goDotEnvVariable("DB_PORT"),
-		DbUser:                    goDotEnvVariable("DB_USER"),
-		DbPassword:                goDotEnvVariable("DB_PASSWORD"),
-		DbName:                    goDotEnvVariable("DB_NAME"),
-		DbSslMode:                 goDotEnvVariable("DB_SSLMODE"),
-		GeoServiceGrpcHost:        goDotEnvVariable("GEO_SERVICE_GRPC_HOST"),
-		KafkaHost:                 goDotEnvVariable("KAFKA_HOST"),
-		KafkaConsumerGroup:        goDotEnvVariable("KAFKA_CONSUMER_GROUP"),
-		KafkaBasketConfirmedTopic: goDotEnvVariable("KAFKA_BASKET_CONFIRMED_TOPIC"),
-		KafkaOrderChangedTopic:    goDotEnvVariable("KAFKA_ORDER_CHANGED_TOPIC"),
+		HttpPort:                     goDotEnvVariable("HTTP_PORT"),
+		DbHost:                       goDotEnvVariable("DB_HOST"),
+		DbPort:                       goDotEnvVariable("DB_PORT"),
+		DbUser:                       goDotEnvVariable("DB_USER"),
+		DbPassword:                   goDotEnvVariable("DB_PASSWORD"),
+		DbName:                       goDotEnvVariable("DB_NAME"),
+		DbSslMode:                    goDotEnvVariable("DB_SSLMODE"),
+		DbMigrationsMode:             goDotEnvVariable("DB_MIGRATIONS_MODE"),
+		GeoServiceGrpcHost:           goDotEnvVariable("GEO_SERVICE_GRPC_HOST"),
+		GeoMode:                      goDotEnvVariable("GEO_MODE"),
+		GeoStaticTable:               goDotEnvVariable("GEO_STATIC_TABLE"),
+		GeoTimeout:                   goDotEnvVariable("GEO_TIMEOUT"),
+		GeoMaxAttempts:               goDotEnvVariable("GEO_MAX_ATTEMPTS"),
+		GeoRetryBackoff:              goDotEnvVariable("GEO_RETRY_BACKOFF"),
+		GeoBreakerFailures:           goDotEnvVariable("GEO_BREAKER_FAILURES"),
+		GeoBreakerCooldown:           goDotEnvVariable("GEO_BREAKER_COOLDOWN"),
+		GeoCacheSize:                 goDotEnvVariable("GEO_CACHE_SIZE"),
+		GeoCacheTTL:                  goDotEnvVariable("GEO_CACHE_TTL"),
+		KafkaHost:                    goDotEnvVariable("KAFKA_HOST"),
+		KafkaConsumerGroup:           goDotEnvVariable("KAFKA_CONSUMER_GROUP"),
+		KafkaBasketConfirmedTopic:    goDotEnvVariable("KAFKA_BASKET_CONFIRMED_TOPIC"),
+		KafkaBasketConfirmedDlqTopic: goDotEnvVariable("KAFKA_BASKET_CONFIRMED_DLQ_TOPIC"),
+		KafkaConsumerMaxAttempts:     goDotEnvVariable("KAFKA_CONSUMER_MAX_ATTEMPTS"),
+		KafkaConsumerRetryBackoff:    goDotEnvVariable("KAFKA_CONSUMER_RETRY_BACKOFF"),
+		KafkaBasketCancelledTopic:    goDotEnvVariable("KAFKA_BASKET_CANCELLED_TOPIC"),
+		KafkaBasketCancelledDlqTopic: goDotEnvVariable("KAFKA_BASKET_CANCELLED_DLQ_TOPIC"),
+		KafkaBasketContentType:       goDotEnvVariable("KAFKA_BASKET_CONTENT_TYPE"),
+		KafkaOrderChangedTopic:       goDotEnvVariable("KAFKA_ORDER_CHANGED_TOPIC"),
+		DispatchStrategy:             goDotEnvVariable("DISPATCH_STRATEGY"),
+		DispatchWeights:              goDotEnvVariable("DISPATCH_WEIGHTS"),
+		AssignOrdersMode:             goDotEnvVariable("ASSIGN_ORDERS_MODE"),
+		GridMaxX:                     goDotEnvVariable("GRID_MAX_X"),
+		GridMaxY:                     goDotEnvVariable("GRID_MAX_Y"),
+		LocationMode:                 goDotEnvVariable("LOCATION_MODE"),
+		GeoArea:                      goDotEnvVariable("GEO_AREA"),
+		PickupLocation:               goDotEnvVariable("PICKUP_LOCATION"),
+		DeliveryMaxAttempts:          goDotEnvVariable("DELIVERY_MAX_ATTEMPTS"),
+		DeliveryRetryDelay:           goDotEnvVariable("DELIVERY_RETRY_DELAY"),
+		HandoverFailureRate:          goDotEnvVariable("HANDOVER_FAILURE_RATE"),
+		DeliveryHoldBack:             goDotEnvVariable("DELIVERY_HOLD_BACK"),
+		DeliveryAllowLate:            goDotEnvVariable("DELIVERY_ALLOW_LATE"),
 	}
 	return config
 }
@@ -130,9 +173,18 @@ func createDBIfNotExists(host, port, user, password, dbName, sslMode string) {
 		}
 	}()
 
-	_, err = db.Exec(fmt.Sprintf("CREATE DATABASE %s", dbName))
+	var exists bool
+	err = db.QueryRow("SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = $1)", dbName).Scan(&exists)
 	if err != nil {
-		log.Printf("Error creating DB (possibly exists already): %v", err)
+		log.Fatalf("Error checking if DB exists: %v", err)
+	}
+	if exists {
+		return
+	}
+
+	_, err = db.Exec(fmt.Sprintf("CREATE DATABASE %s", pq.QuoteIdentifier(dbName)))
+	if err != nil {
+		log.Fatalf("Error creating DB: %v", err)
 	}
 }
 
@@ -149,18 +201,128 @@ func mustGormOpen(connectionString string) *gorm.DB {
 	return pgGorm
 }
 
-func mustAutoMigrate(db *gorm.DB) {
-	err := db.AutoMigrate(&orderrepo.OrderDTO{})
+const (
+	// migrationsModeAuto applies pending migrations on startup
+	migrationsModeAuto = "auto"
+	// migrationsModeVerify refuses to start until migrations are applied with 'migrate up'
+	migrationsModeVerify = "verify"
+)
+
+func mustNewMigrator(db *gorm.DB) *migrations.Migrator {
+	sqlDB, err := db.DB()
 	if err != nil {
-		log.Fatalf("Migration error: %v", err)
+		log.Fatalf("error getting sql.DB from Gorm: %v", err)
+	}
+	migrator, err := migrations.NewMigrator(sqlDB)
+	if err != nil {
+		log.Fatalf("error loading migrations: %v", err)
+	}
+	return migrator
+}
+
+func mustPrepareSchema(db *gorm.DB, mode string) {
+	ctx := context.Background()
+	migrator := mustNewMigrator(db)
+
+	switch mode {
+	case migrationsModeAuto:
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			log.Fatalf("Migration error: %v", err)
+		}
+		for _, migration := range applied {
+			log.Infof("Applied migration %d_%s", migration.Version, migration.Name)
+		}
+	case migrationsModeVerify, "":
+		if err := migrator.Verify(ctx); err != nil {
+			log.Fatalf("Refusing to start: %v", err)
+		}
+	default:
+		log.Fatalf("unknown DB_MIGRATIONS_MODE %q, expected %q or %q", mode, migrationsModeAuto, migrationsModeVerify)
+	}
+}
+
+// runMigrateCommand handles 'migrate up|down|status'
+func runMigrateCommand(config cmd.Config, connectionString string, args []string) {
+	if len(args) != 1 {
+		log.Fatalf("usage: %s migrate up|down|status", os.Args[0])
+	}
+	ctx := context.Background()
+
+	if args[0] == "up" {
+		createDBIfNotExists(
+			config.DbHost,
+			config.DbPort,
+			config.DbUser,
+			config.DbPassword,
+			config.DbName,
+			config.DbSslMode,
+		)
+	}
+	migrator := mustNewMigrator(mustGormOpen(connectionString))
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			log.Fatalf("Migration error: %v", err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("Database is up to date")
+		}
+		for _, migration := range applied {
+			fmt.Printf("Applied %d_%s\n", migration.Version, migration.Name)
+		}
+	case "down":
+		migration, err := migrator.Down(ctx)
+		if err != nil {
+			log.Fatalf("Rollback error: %v", err)
+		}
+		fmt.Printf("Rolled back %d_%s\n", migration.Version, migration.Name)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatalf("Status error: %v", err)
+		}
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-40s %s\n", status.Version, status.Name, appliedAt)
+		}
+	default:
+		log.Fatalf("unknown migrate command %q, expected up, down or status", args[0])
+	}
+}
+
+// runDeadLetterCommand handles 'dlq replay', which sends messages from the dead letter topics
+// back to the topics they came from
+func runDeadLetterCommand(config cmd.Config, args []string) {
+	if len(args) != 1 || args[0] != "replay" {
+		log.Fatalf("usage: %s dlq replay", os.Args[0])
 	}
-	err = db.AutoMigrate(&courierrepo.StoragePlaceDTO{})
+
+	replayDeadLetters(config, config.KafkaBasketConfirmedDlqTopic, config.KafkaBasketConfirmedTopic)
+	replayDeadLetters(config, config.KafkaBasketCancelledDlqTopic, config.KafkaBasketCancelledTopic)
+}
+
+func replayDeadLetters(config cmd.Config, dlqTopic, defaultTopic string) {
+	replayer, err := kafkain.NewDeadLetterReplayer(
+		[]string{config.KafkaHost},
+		config.KafkaConsumerGroup+"-dlq-replay",
+		dlqTopic,
+		defaultTopic,
+	)
 	if err != nil {
-		log.Fatalf("Migration error: %v", err)
+		log.Fatalf("cannot create DeadLetterReplayer: %v", err)
 	}
-	err = db.AutoMigrate(&courierrepo.CourierDTO{})
+	defer replayer.Close()
+
+	replayed, err := replayer.Replay(context.Background())
+	fmt.Printf("Replayed %d messages from %s\n", replayed, dlqTopic)
 	if err != nil {
-		log.Fatalf("Migration error: %v", err)
+		log.Errorf("Replay error: %v", err)
 	}
 }
 
@@ -168,8 +330,18 @@ func startWebServer(compositionRoot *cmd.CompositionRoot, port string) {
 	handlers, err := httpadapter.NewServer(
 		compositionRoot.NewCreateOrderHandler(),
 		compositionRoot.NewCreateCourierHandler(),
+		compositionRoot.NewCancelOrderHandler(),
+		compositionRoot.NewStartShiftHandler(),
+		compositionRoot.NewEndShiftHandler(),
+		compositionRoot.NewUpdateCourierHandler(),
+		compositionRoot.NewDeleteCourierHandler(),
+		compositionRoot.NewAddStoragePlaceHandler(),
+		compositionRoot.NewRemoveStoragePlaceHandler(),
+		compositionRoot.NewResizeStoragePlaceHandler(),
 		compositionRoot.NewGetAllCouriersHandler(),
+		compositionRoot.NewGetCourierHandler(),
 		compositionRoot.NewGetIncompleteOrdersHandler(),
+		compositionRoot.NewGetOrderHandler(),
 	)
 	if err != nil {
 		log.Fatalf("HTTP Server initialization error: %v", err)
@@ -184,10 +356,7 @@ func startWebServer(compositionRoot *cmd.CompositionRoot, port string) {
 		e.DefaultHTTPErrorHandler(err, c)
 	}
 
-	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.OPTIONS},
-	}))
+	registerCORS(e)
 
 	e.Pre(middleware.RemoveTrailingSlash())
 
@@ -195,6 +364,7 @@ func startWebServer(compositionRoot *cmd.CompositionRoot, port string) {
 	registerSwaggerOpenAPI(e)
 	registerSwaggerUI(e)
 	registerHealthCheck(e)
+	registerStats(e)
 
 	// Register API handlers
 	servers.RegisterHandlers(e, handlers)
@@ -202,6 +372,14 @@ func startWebServer(compositionRoot *cmd.CompositionRoot, port string) {
 	e.Logger.Fatal(e.Start(fmt.Sprintf("0.0.0.0:%s", port)))
 }
 
+// registerCORS allows every method of the API, PATCH included, to be called from a browser
+func registerCORS(e *echo.Echo) {
+	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		AllowOrigins: []string{"*"},
+		AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.PATCH, echo.DELETE, echo.OPTIONS},
+	}))
+}
+
 func registerSwaggerOpenAPI(e *echo.Echo) {
 	e.GET("/openapi.json", func(c echo.Context) error {
 		swagger, err := servers.GetSwagger()
@@ -251,6 +429,11 @@ func registerHealthCheck(e *echo.Echo) {
 	})
 }
 
+// registerStats exposes runtime and geo cache stats published with expvar
+func registerStats(e *echo.Echo) {
+	e.GET("/debug/vars", echo.WrapHandler(expvar.Handler()))
+}
+
 func startCronJobs(compositionRoot *cmd.CompositionRoot) {
 	log.Info("Starting cron jobs")
 	c := cron.New()
@@ -259,11 +442,16 @@ func startCronJobs(compositionRoot *cmd.CompositionRoot) {
 		log.Fatalf("error adding cron job: %v", err)
 	}
 	log.Info("AssignOrderJob entry: ", entry)
-	entry, err = c.AddJob("@every 1s", compositionRoot.NewMoveCouriersJob())
+	entry, err = c.AddJob(fmt.Sprintf("@every %s", cmd.MoveCouriersInterval), compositionRoot.NewMoveCouriersJob())
 	if err != nil {
 		log.Fatalf("error adding cron job: %v", err)
 	}
 	log.Printf("MoveCouriersJob entry: %v", entry)
+	entry, err = c.AddJob("@every 1s", compositionRoot.NewOutboxJob())
+	if err != nil {
+		log.Fatalf("error adding cron job: %v", err)
+	}
+	log.Printf("OutboxJob entry: %v", entry)
 	c.Start()
 	log.Info("Cron scheduler started")
 }
@@ -275,3 +463,11 @@ func startBasketConfirmedConsumer(compositionRoot *cmd.CompositionRoot) {
 		}
 	}()
 }
+
+func startBasketCancelledConsumer(compositionRoot *cmd.CompositionRoot) {
+	go func() {
+		if err := compositionRoot.NewBasketCancelledConsumer().Consume(); err != nil {
+			log.Fatalf("Kafka consumer error: %v", err)
+		}
+	}()
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CORSAllowsPatchPreflight(t *testing.T) {
+	// Arrange
+	e := echo.New()
+	registerCORS(e)
+	e.PATCH("/api/v1/couriers/:courierId", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	req := httptest.NewRequest(http.MethodOptions, "/api/v1/couriers/1", nil)
+	req.Header.Set(echo.HeaderOrigin, "http://localhost:3000")
+	req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodPatch)
+	rec := httptest.NewRecorder()
+
+	// Act
+	e.ServeHTTP(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	allowed := strings.Split(rec.Header().Get(echo.HeaderAccessControlAllowMethods), ",")
+	assert.Contains(t, allowed, http.MethodPatch)
+}
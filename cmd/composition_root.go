@@ -3,43 +3,201 @@ package cmd
 
 import (
 	grpcgeo "delivery/internal/adapters/out/grpc/geo"
+	"delivery/internal/adapters/out/staticgeo"
 	kafkabasket "delivery/internal/adapters/in/kafka"
+	"delivery/internal/adapters/out/handover"
+	kafkaorder "delivery/internal/adapters/out/kafka"
 	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/application/eventhandlers"
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
 	"delivery/internal/jobs"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/outbox"
+	"expvar"
+	"fmt"
 	"log"
+	"reflect"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/robfig/cron/v3"
 	"gorm.io/gorm"
 )
 
 type CompositionRoot struct {
-	configs   Config
-	gormDB    *gorm.DB
-	geoClient ports.GeoClient
+	configs       Config
+	gormDB        *gorm.DB
+	geoClient     ports.GeoClient
+	orderProducer ports.OrderProducer
+	mediatr       ddd.Mediatr
+	dispatcher    services.OrderDispatcherService
 
-	onceGeo sync.Once
-	closers []Closer
+	onceGeo           sync.Once
+	onceOrderProducer sync.Once
+	onceMediatr       sync.Once
+	onceDispatcher    sync.Once
+	closers           []Closer
 }
 
 func NewCompositionRoot(configs Config, gormDB *gorm.DB) *CompositionRoot {
+	grid, err := kernel.NewGrid(
+		parseIntOrDefault(configs.GridMaxX, "GRID_MAX_X", kernel.DefaultMaxX),
+		parseIntOrDefault(configs.GridMaxY, "GRID_MAX_Y", kernel.DefaultMaxY),
+	)
+	if err != nil {
+		log.Fatalf("cannot create Grid: %v", err)
+	}
+	kernel.SetGrid(grid)
+
+	switch configs.LocationMode {
+	case LocationModeGrid, "":
+		kernel.UseGridCoordinates()
+	case LocationModeGeo:
+		area, err := parseGeoArea(configs.GeoArea)
+		if err != nil {
+			log.Fatalf("cannot parse GEO_AREA: %v", err)
+		}
+		kernel.UseGeoCoordinates(area)
+	default:
+		log.Fatalf("unknown LOCATION_MODE %q, expected %q or %q", configs.LocationMode, LocationModeGrid, LocationModeGeo)
+	}
+
 	return &CompositionRoot{
 		configs: configs,
 		gormDB:  gormDB,
 	}
 }
 
+// NewOrderDispatcherService is shared, so stateful strategies like round robin remember previous choices
 func (cr *CompositionRoot) NewOrderDispatcherService() services.OrderDispatcherService {
-	orderDispatcherService := services.NewOrderDispatcherService()
-	return orderDispatcherService
+	cr.onceDispatcher.Do(func() {
+		weights, err := parseDispatchWeights(cr.configs.DispatchWeights)
+		if err != nil {
+			log.Fatalf("cannot parse dispatch weights: %v", err)
+		}
+		strategy, err := services.NewDispatchStrategy(cr.configs.DispatchStrategy, weights)
+		if err != nil {
+			log.Fatalf("cannot create DispatchStrategy: %v", err)
+		}
+		holdBack := services.DefaultHoldBack
+		if cr.configs.DeliveryHoldBack != "" {
+			holdBack, err = time.ParseDuration(cr.configs.DeliveryHoldBack)
+			if err != nil {
+				log.Fatalf("cannot parse DELIVERY_HOLD_BACK: %v", err)
+			}
+		}
+		allowLate := services.DefaultAllowLate
+		if cr.configs.DeliveryAllowLate != "" {
+			allowLate, err = strconv.ParseBool(cr.configs.DeliveryAllowLate)
+			if err != nil {
+				log.Fatalf("cannot parse DELIVERY_ALLOW_LATE: %v", err)
+			}
+		}
+		window, err := services.NewDeliveryWindowPolicy(MoveCouriersInterval, holdBack, allowLate, time.Now)
+		if err != nil {
+			log.Fatalf("cannot create DeliveryWindowPolicy: %v", err)
+		}
+		dispatcher, err := services.NewOrderDispatcherServiceWithStrategy(strategy, window)
+		if err != nil {
+			log.Fatalf("cannot create OrderDispatcherService: %v", err)
+		}
+		cr.dispatcher = dispatcher
+	})
+	return cr.dispatcher
+}
+
+// parseDispatchWeights parses weights like "nearest=0.6,least_loaded=0.4"
+func parseDispatchWeights(raw string) (map[string]float64, error) {
+	weights := make(map[string]float64)
+	if strings.TrimSpace(raw) == "" {
+		return weights, nil
+	}
+	for _, pair := range strings.Split(raw, ",") {
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("expected name=weight, got %q", pair)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for %q: %w", name, err)
+		}
+		weights[strings.TrimSpace(name)] = weight
+	}
+	return weights, nil
+}
+
+// parsePickupLocation reads "x,y" of the warehouse where couriers collect orders,
+// or "latitude,longitude" in geo mode. The lowest location is used when it is not configured
+func parsePickupLocation(raw string) (kernel.Location, error) {
+	if strings.TrimSpace(raw) == "" {
+		return kernel.MinLocation(), nil
+	}
+	rawX, rawY, ok := strings.Cut(raw, ",")
+	if !ok {
+		return kernel.Location{}, fmt.Errorf("expected x,y, got %q", raw)
+	}
+	if kernel.GeoCoordinatesEnabled() {
+		latitude, err := strconv.ParseFloat(strings.TrimSpace(rawX), 64)
+		if err != nil {
+			return kernel.Location{}, fmt.Errorf("invalid latitude: %w", err)
+		}
+		longitude, err := strconv.ParseFloat(strings.TrimSpace(rawY), 64)
+		if err != nil {
+			return kernel.Location{}, fmt.Errorf("invalid longitude: %w", err)
+		}
+		return kernel.NewGeoLocation(latitude, longitude)
+	}
+	x, err := strconv.Atoi(strings.TrimSpace(rawX))
+	if err != nil {
+		return kernel.Location{}, fmt.Errorf("invalid x: %w", err)
+	}
+	y, err := strconv.Atoi(strings.TrimSpace(rawY))
+	if err != nil {
+		return kernel.Location{}, fmt.Errorf("invalid y: %w", err)
+	}
+	return kernel.NewLocation(x, y)
+}
+
+// parseGeoArea reads "south,west,north,east" bounds in degrees, Moscow is used when it is not configured
+func parseGeoArea(raw string) (kernel.GeoArea, error) {
+	if strings.TrimSpace(raw) == "" {
+		return kernel.DefaultGeoArea(), nil
+	}
+	parts := strings.Split(raw, ",")
+	if len(parts) != 4 {
+		return kernel.GeoArea{}, fmt.Errorf("expected south,west,north,east, got %q", raw)
+	}
+	bounds := make([]float64, 0, len(parts))
+	for _, part := range parts {
+		bound, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return kernel.GeoArea{}, fmt.Errorf("invalid bound %q: %w", part, err)
+		}
+		bounds = append(bounds, bound)
+	}
+	return kernel.NewGeoArea(bounds[0], bounds[1], bounds[2], bounds[3])
+}
+
+// NewMediatr returns the in-process domain event bus shared by all units of work
+func (cr *CompositionRoot) NewMediatr() ddd.Mediatr {
+	cr.onceMediatr.Do(func() {
+		cr.mediatr = ddd.NewMediatr()
+		cr.mediatr.Subscribe(eventhandlers.NewOrderDeliveryPeriodMissedHandler(),
+			&order.OrderDeliveryPeriodMissedDomainEvent{})
+	})
+	return cr.mediatr
 }
 
 func (cr *CompositionRoot) NewUnitOfWork() ports.UnitOfWork {
-	unitOfWork, err := postgres.NewUnitOfWork(cr.gormDB)
+	unitOfWork, err := postgres.NewUnitOfWork(cr.gormDB, cr.NewMediatr(), cr.NewEventRegistry())
 	if err != nil {
 		log.Fatalf("cannot create UnitOfWork: %v", err)
 	}
@@ -47,7 +205,7 @@ func (cr *CompositionRoot) NewUnitOfWork() ports.UnitOfWork {
 }
 
 func (cr *CompositionRoot) NewUnitOfWorkFactory() ports.UnitOfWorkFactory {
-	factory, err := postgres.NewUnitOfWorkFactory(cr.gormDB)
+	factory, err := postgres.NewUnitOfWorkFactory(cr.gormDB, cr.NewMediatr(), cr.NewEventRegistry())
 	if err != nil {
 		log.Fatalf("cannot create UnitOfWorkFactory: %v", err)
 	}
@@ -55,13 +213,57 @@ func (cr *CompositionRoot) NewUnitOfWorkFactory() ports.UnitOfWorkFactory {
 }
 
 func (cr *CompositionRoot) NewCreateOrderHandler() commands.CreateOrderHandler {
-	handler, err := commands.NewCreateOrderHandler(cr.NewUnitOfWorkFactory(), cr.NewGeoClient())
+	pickup, err := parsePickupLocation(cr.configs.PickupLocation)
+	if err != nil {
+		log.Fatalf("cannot parse PICKUP_LOCATION: %v", err)
+	}
+	handler, err := commands.NewCreateOrderHandler(cr.NewUnitOfWorkFactory(), cr.NewGeoClient(), pickup)
 	if err != nil {
 		log.Fatalf("cannot create CreateOrderHandler: %v", err)
 	}
 	return handler
 }
 
+func (cr *CompositionRoot) NewCancelOrderHandler() commands.CancelOrderHandler {
+	handler, err := commands.NewCancelOrderHandler(cr.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("cannot create CancelOrderHandler: %v", err)
+	}
+	return handler
+}
+
+func (cr *CompositionRoot) NewStartShiftHandler() commands.StartShiftHandler {
+	handler, err := commands.NewStartShiftHandler(cr.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("cannot create StartShiftHandler: %v", err)
+	}
+	return handler
+}
+
+func (cr *CompositionRoot) NewEndShiftHandler() commands.EndShiftHandler {
+	handler, err := commands.NewEndShiftHandler(cr.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("cannot create EndShiftHandler: %v", err)
+	}
+	return handler
+}
+
+func (cr *CompositionRoot) NewUpdateCourierHandler() commands.UpdateCourierHandler {
+	handler, err := commands.NewUpdateCourierHandler(cr.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("cannot create UpdateCourierHandler: %v", err)
+	}
+	return handler
+}
+
+func (cr *CompositionRoot) NewDeleteCourierHandler() commands.DeleteCourierHandler {
+	handler, err := commands.NewDeleteCourierHandler(cr.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("cannot create DeleteCourierHandler: %v", err)
+	}
+	return handler
+}
+
 func (cr *CompositionRoot) NewCreateCourierHandler() commands.CreateCourierHandler {
 	handler, err := commands.NewCreateCourierHandler(cr.NewUnitOfWorkFactory())
 	if err != nil {
@@ -78,8 +280,35 @@ func (cr *CompositionRoot) NewAddStoragePlaceHandler() commands.AddStoragePlaceH
 	return handler
 }
 
+func (cr *CompositionRoot) NewRemoveStoragePlaceHandler() commands.RemoveStoragePlaceHandler {
+	handler, err := commands.NewRemoveStoragePlaceHandler(cr.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("cannot create NewRemoveStoragePlaceHandler: %v", err)
+	}
+	return handler
+}
+
+func (cr *CompositionRoot) NewResizeStoragePlaceHandler() commands.ResizeStoragePlaceHandler {
+	handler, err := commands.NewResizeStoragePlaceHandler(cr.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("cannot create NewResizeStoragePlaceHandler: %v", err)
+	}
+	return handler
+}
+
 func (cr *CompositionRoot) NewMoveCouriersHandler() commands.MoveCouriersHandler {
-	handler, err := commands.NewMoveCouriersHandler(cr.NewUnitOfWorkFactory())
+	maxDeliveryAttempts := defaultMaxDeliveryAttempts
+	if cr.configs.DeliveryMaxAttempts != "" {
+		var err error
+		maxDeliveryAttempts, err = strconv.Atoi(cr.configs.DeliveryMaxAttempts)
+		if err != nil {
+			log.Fatalf("cannot parse DELIVERY_MAX_ATTEMPTS: %v", err)
+		}
+	}
+	redeliveryDelay := parseDurationOrDefault(cr.configs.DeliveryRetryDelay, "DELIVERY_RETRY_DELAY", defaultRedeliveryDelay)
+	handler, err := commands.NewMoveCouriersHandler(
+		cr.NewUnitOfWorkFactory(), cr.NewHandoverClient(), maxDeliveryAttempts, redeliveryDelay,
+	)
 	if err != nil {
 		log.Fatalf("cannot create MoveCouriersHandler: %v", err)
 	}
@@ -114,7 +343,42 @@ func (cr *CompositionRoot) NewGetIncompleteOrdersHandler() queries.GetIncomplete
 	return handler
 }
 
+func (cr *CompositionRoot) NewGetCourierHandler() queries.GetCourierHandler {
+	handler, err := queries.NewGetCourierHandler(cr.gormDB)
+	if err != nil {
+		log.Fatalf("cannot create GetCourierHandler: %v", err)
+	}
+	return handler
+}
+
+func (cr *CompositionRoot) NewGetOrderHandler() queries.GetOrderHandler {
+	handler, err := queries.NewGetOrderHandler(cr.gormDB)
+	if err != nil {
+		log.Fatalf("cannot create GetOrderHandler: %v", err)
+	}
+	return handler
+}
+
+func (cr *CompositionRoot) NewAssignOrdersBatchHandler() commands.AssignOrdersBatchHandler {
+	handler, err := commands.NewAssignOrdersBatchHandler(
+		cr.NewUnitOfWorkFactory(), cr.NewOrderDispatcherService(),
+	)
+	if err != nil {
+		log.Fatalf("cannot create AssignOrdersBatchHandler: %v", err)
+	}
+	return handler
+}
+
+// NewAssignOrderJob assigns one order per run, or all pending orders at once in batch mode
 func (cr *CompositionRoot) NewAssignOrderJob() cron.Job {
+	if cr.configs.AssignOrdersMode == AssignOrdersModeBatch {
+		job, err := jobs.NewAssignOrdersBatchJob(cr.NewAssignOrdersBatchHandler())
+		if err != nil {
+			log.Fatalf("cannot create AssignOrdersBatchJob: %v", err)
+		}
+		return job
+	}
+
 	job, err := jobs.NewAssignOrdersJob(cr.NewAssignOrderHandler())
 	if err != nil {
 		log.Fatalf("cannot create AssignOrdersJob: %v", err)
@@ -130,23 +394,136 @@ func (cr *CompositionRoot) NewMoveCouriersJob() cron.Job {
 	return job
 }
 
+func (cr *CompositionRoot) NewHandoverClient() ports.HandoverClient {
+	failureRate := 0.0
+	if cr.configs.HandoverFailureRate != "" {
+		var err error
+		failureRate, err = strconv.ParseFloat(cr.configs.HandoverFailureRate, 64)
+		if err != nil {
+			log.Fatalf("cannot parse HANDOVER_FAILURE_RATE: %v", err)
+		}
+	}
+	client, err := handover.NewSimulatedClient(failureRate)
+	if err != nil {
+		log.Fatalf("cannot create HandoverClient: %v", err)
+	}
+	return client
+}
+
+// NewGeoClient selects the geocoder by GEO_MODE. In fallback mode the built-in geocoder answers
+// while the geo service is down, its answers are not cached
 func (cr *CompositionRoot) NewGeoClient() ports.GeoClient {
 	cr.onceGeo.Do(func() {
-		client, err := grpcgeo.NewClient(cr.configs.GeoServiceGrpcHost)
-		if err != nil {
-			log.Fatalf("cannot create GeoClient: %v", err)
+		// The geo service knows grid cells only
+		if kernel.GeoCoordinatesEnabled() && cr.configs.GeoMode != GeoModeStatic {
+			log.Fatalf("LOCATION_MODE %q needs GEO_MODE %q", LocationModeGeo, GeoModeStatic)
+		}
+		switch cr.configs.GeoMode {
+		case GeoModeGrpc, "":
+			cr.geoClient = cr.newGrpcGeoClient()
+		case GeoModeStatic:
+			cr.geoClient = cr.newStaticGeoClient()
+		case GeoModeFallback:
+			client, err := staticgeo.NewFallbackClient(cr.newGrpcGeoClient(), cr.newStaticGeoClient())
+			if err != nil {
+				log.Fatalf("cannot create fallback GeoClient: %v", err)
+			}
+			cr.geoClient = client
+		default:
+			log.Fatalf("unknown GEO_MODE %q, expected %q, %q or %q",
+				cr.configs.GeoMode, GeoModeGrpc, GeoModeStatic, GeoModeFallback)
 		}
-		cr.RegisterCloser(client)
-		cr.geoClient = client
 	})
 	return cr.geoClient
 }
 
+func (cr *CompositionRoot) newStaticGeoClient() ports.GeoClient {
+	streets := make(map[string]kernel.Location)
+	if cr.configs.GeoStaticTable != "" {
+		var err error
+		streets, err = staticgeo.LoadTable(cr.configs.GeoStaticTable)
+		if err != nil {
+			log.Fatalf("cannot load GEO_STATIC_TABLE: %v", err)
+		}
+	}
+	client, err := staticgeo.NewClient(streets)
+	if err != nil {
+		log.Fatalf("cannot create static GeoClient: %v", err)
+	}
+	return client
+}
+
+// newGrpcGeoClient puts the cache in front, so that cached addresses are found even when the geo service is down,
+// then the circuit breaker, so that retries are not made while the geo service is considered down
+func (cr *CompositionRoot) newGrpcGeoClient() ports.GeoClient {
+	timeout := parseDurationOrDefault(cr.configs.GeoTimeout, "GEO_TIMEOUT", grpcgeo.DefaultTimeout)
+	grpcClient, err := grpcgeo.NewClient(cr.configs.GeoServiceGrpcHost, timeout)
+	if err != nil {
+		log.Fatalf("cannot create GeoClient: %v", err)
+	}
+	cr.RegisterCloser(grpcClient)
+
+	retrying, err := grpcgeo.NewRetryingClient(
+		grpcClient,
+		parseIntOrDefault(cr.configs.GeoMaxAttempts, "GEO_MAX_ATTEMPTS", defaultGeoMaxAttempts),
+		parseDurationOrDefault(cr.configs.GeoRetryBackoff, "GEO_RETRY_BACKOFF", defaultGeoRetryBackoff),
+	)
+	if err != nil {
+		log.Fatalf("cannot create retrying GeoClient: %v", err)
+	}
+	breaker, err := grpcgeo.NewCircuitBreakerClient(
+		retrying,
+		parseIntOrDefault(cr.configs.GeoBreakerFailures, "GEO_BREAKER_FAILURES", defaultGeoBreakerFailures),
+		parseDurationOrDefault(cr.configs.GeoBreakerCooldown, "GEO_BREAKER_COOLDOWN", defaultGeoBreakerCooldown),
+		time.Now,
+	)
+	if err != nil {
+		log.Fatalf("cannot create circuit breaker GeoClient: %v", err)
+	}
+	cache, err := grpcgeo.NewCachingClient(
+		breaker,
+		parseIntOrDefault(cr.configs.GeoCacheSize, "GEO_CACHE_SIZE", defaultGeoCacheSize),
+		parseDurationOrDefault(cr.configs.GeoCacheTTL, "GEO_CACHE_TTL", defaultGeoCacheTTL),
+		time.Now,
+	)
+	if err != nil {
+		log.Fatalf("cannot create caching GeoClient: %v", err)
+	}
+	expvar.Publish("geo_cache", expvar.Func(func() any { return cache.Stats() }))
+
+	return cache
+}
+
+func parseIntOrDefault(raw, name string, defaultValue int) int {
+	if raw == "" {
+		return defaultValue
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		log.Fatalf("cannot parse %s: %v", name, err)
+	}
+	return value
+}
+
+func parseDurationOrDefault(raw, name string, defaultValue time.Duration) time.Duration {
+	if raw == "" {
+		return defaultValue
+	}
+	value, err := time.ParseDuration(raw)
+	if err != nil {
+		log.Fatalf("cannot parse %s: %v", name, err)
+	}
+	return value
+}
+
 func (cr *CompositionRoot) NewBasketConfirmedConsumer() kafkabasket.BasketConfirmedConsumer {
 	consumer, err := kafkabasket.NewBasketConfirmedConsumer(
 		[]string{cr.configs.KafkaHost},
 		cr.configs.KafkaConsumerGroup,
 		cr.configs.KafkaBasketConfirmedTopic,
+		cr.configs.KafkaBasketConfirmedDlqTopic,
+		cr.newConsumerRetryPolicy(),
+		cr.configs.KafkaBasketContentType,
 		cr.NewCreateOrderHandler(),
 	)
 	if err != nil {
@@ -155,3 +532,98 @@ func (cr *CompositionRoot) NewBasketConfirmedConsumer() kafkabasket.BasketConfir
 	cr.RegisterCloser(consumer)
 	return consumer
 }
+
+func (cr *CompositionRoot) newConsumerRetryPolicy() kafkabasket.RetryPolicy {
+	maxAttempts := defaultConsumerMaxAttempts
+	if cr.configs.KafkaConsumerMaxAttempts != "" {
+		var err error
+		maxAttempts, err = strconv.Atoi(cr.configs.KafkaConsumerMaxAttempts)
+		if err != nil {
+			log.Fatalf("cannot parse KAFKA_CONSUMER_MAX_ATTEMPTS: %v", err)
+		}
+	}
+	backoff := defaultConsumerRetryBackoff
+	if cr.configs.KafkaConsumerRetryBackoff != "" {
+		var err error
+		backoff, err = time.ParseDuration(cr.configs.KafkaConsumerRetryBackoff)
+		if err != nil {
+			log.Fatalf("cannot parse KAFKA_CONSUMER_RETRY_BACKOFF: %v", err)
+		}
+	}
+	policy, err := kafkabasket.NewRetryPolicy(maxAttempts, backoff, max(backoff, consumerMaxRetryBackoff))
+	if err != nil {
+		log.Fatalf("cannot create consumer RetryPolicy: %v", err)
+	}
+	return policy
+}
+
+func (cr *CompositionRoot) NewBasketCancelledConsumer() kafkabasket.BasketCancelledConsumer {
+	consumer, err := kafkabasket.NewBasketCancelledConsumer(
+		[]string{cr.configs.KafkaHost},
+		cr.configs.KafkaConsumerGroup,
+		cr.configs.KafkaBasketCancelledTopic,
+		cr.configs.KafkaBasketCancelledDlqTopic,
+		cr.newConsumerRetryPolicy(),
+		cr.configs.KafkaBasketContentType,
+		cr.NewCancelOrderHandler(),
+	)
+	if err != nil {
+		log.Fatalf("cannot create BasketCancelledConsumer: %v", err)
+	}
+	cr.RegisterCloser(consumer)
+	return consumer
+}
+
+func (cr *CompositionRoot) NewOrderProducer() ports.OrderProducer {
+	cr.onceOrderProducer.Do(func() {
+		producer, err := kafkaorder.NewOrderProducer(
+			[]string{cr.configs.KafkaHost},
+			cr.configs.KafkaOrderChangedTopic,
+		)
+		if err != nil {
+			log.Fatalf("cannot create OrderProducer: %v", err)
+		}
+		cr.RegisterCloser(producer)
+		cr.orderProducer = producer
+	})
+	return cr.orderProducer
+}
+
+func (cr *CompositionRoot) NewOutboxRepository() ports.OutboxRepository {
+	repository, err := outboxrepo.NewRepository(cr.gormDB)
+	if err != nil {
+		log.Fatalf("cannot create OutboxRepository: %v", err)
+	}
+	return repository
+}
+
+func (cr *CompositionRoot) NewEventRegistry() outbox.EventRegistry {
+	registry, err := outbox.NewEventRegistry()
+	if err != nil {
+		log.Fatalf("cannot create EventRegistry: %v", err)
+	}
+	// Only events mapped to integration messages are registered, the rest stay in process
+	domainEvents := []reflect.Type{
+		reflect.TypeOf(order.OrderCreatedDomainEvent{}),
+		reflect.TypeOf(order.OrderAssignedDomainEvent{}),
+		reflect.TypeOf(order.OrderPickedUpDomainEvent{}),
+		reflect.TypeOf(order.OrderCompletedDomainEvent{}),
+		reflect.TypeOf(order.OrderCancelledDomainEvent{}),
+		reflect.TypeOf(order.OrderDeliveryFailedDomainEvent{}),
+		reflect.TypeOf(order.OrderReturnedToSenderDomainEvent{}),
+	}
+	for _, eventType := range domainEvents {
+		if err := registry.RegisterDomainEvent(eventType); err != nil {
+			log.Fatalf("cannot register domain event %v: %v", eventType, err)
+		}
+	}
+	return registry
+}
+
+func (cr *CompositionRoot) NewOutboxJob() cron.Job {
+	job, err := jobs.NewOutboxJob(cr.NewOutboxRepository(), cr.NewEventRegistry(), cr.NewOrderProducer())
+	if err != nil {
+		log.Fatalf("cannot create OutboxJob: %v", err)
+	}
+	return job
+}
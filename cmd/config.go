@@ -1,16 +1,91 @@
 package cmd
 
+import "time"
+
+const (
+	AssignOrdersModeSingle = "single"
+	AssignOrdersModeBatch  = "batch"
+)
+
+// A failed drop-off is visited again after a delay, so that the recipient has time to come back
+const (
+	defaultMaxDeliveryAttempts = 3
+	defaultRedeliveryDelay     = 10 * time.Minute
+)
+
+// Consumers retry transient errors with growing backoff before sending a message to the dead letter topic
+const (
+	defaultConsumerMaxAttempts  = 5
+	defaultConsumerRetryBackoff = 500 * time.Millisecond
+	consumerMaxRetryBackoff     = 30 * time.Second
+)
+
+// GEO_MODE values: the geo service, the built-in geocoder, or the geo service with the built-in one behind it
+const (
+	GeoModeGrpc     = "grpc"
+	GeoModeStatic   = "static"
+	GeoModeFallback = "fallback"
+)
+
+// Geo service calls are retried and cached, and skipped for a while after it fails several times in a row
+const (
+	defaultGeoMaxAttempts     = 3
+	defaultGeoRetryBackoff    = 200 * time.Millisecond
+	defaultGeoBreakerFailures = 5
+	defaultGeoBreakerCooldown = 30 * time.Second
+	defaultGeoCacheSize       = 1000
+	defaultGeoCacheTTL        = 24 * time.Hour
+)
+
+// LOCATION_MODE values: cells of the city grid, or WGS84 latitude and longitude
+const (
+	LocationModeGrid = "grid"
+	LocationModeGeo  = "geo"
+)
+
+// MoveCouriersInterval is how often couriers make a step, so travel time in steps can be put on the clock
+const MoveCouriersInterval = time.Second
+
 type Config struct {
-	HttpPort                  string
-	DbHost                    string
-	DbPort                    string
-	DbUser                    string
-	DbPassword                string
-	DbName                    string
-	DbSslMode                 string
-	GeoServiceGrpcHost        string
-	KafkaHost                 string
-	KafkaConsumerGroup        string
-	KafkaBasketConfirmedTopic string
-	KafkaOrderChangedTopic    string
+	HttpPort                     string
+	DbHost                       string
+	DbPort                       string
+	DbUser                       string
+	DbPassword                   string
+	DbName                       string
+	DbSslMode                    string
+	DbMigrationsMode             string
+	GeoServiceGrpcHost           string
+	GeoMode                      string
+	GeoStaticTable               string
+	GeoTimeout                   string
+	GeoMaxAttempts               string
+	GeoRetryBackoff              string
+	GeoBreakerFailures           string
+	GeoBreakerCooldown           string
+	GeoCacheSize                 string
+	GeoCacheTTL                  string
+	KafkaHost                    string
+	KafkaConsumerGroup           string
+	KafkaBasketConfirmedTopic    string
+	KafkaBasketConfirmedDlqTopic string
+	KafkaConsumerMaxAttempts     string
+	KafkaConsumerRetryBackoff    string
+	KafkaBasketCancelledTopic    string
+	KafkaBasketCancelledDlqTopic string
+	KafkaBasketContentType       string
+	KafkaOrderChangedTopic       string
+	DispatchStrategy             string
+	DispatchWeights              string
+	AssignOrdersMode             string
+	GridMaxX                     string
+	GridMaxY                     string
+	LocationMode                 string
+	GeoArea                      string
+	PickupLocation               string
+	DeliveryMaxAttempts          string
+	DeliveryRetryDelay           string
+	HandoverFailureRate          string
+	DeliveryHoldBack             string
+	DeliveryAllowLate            string
 }
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Server) AddStoragePlace(c echo.Context, courierId openapi_types.UUID) error {
+	var storagePlace servers.NewStoragePlace
+	if err := c.Bind(&storagePlace); err != nil {
+		return problems.NewBadRequest("invalid request body: " + err.Error())
+	}
+
+	volume, err := kernel.NewVolume(storagePlace.TotalVolume)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	equipment, err := parseEquipment(storagePlace)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	addStoragePlaceCommand, err := commands.NewAddStoragePlaceCommand(courierId, storagePlace.Name, *volume, equipment)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.addStoragePlaceHandler.Handle(c.Request().Context(), addStoragePlaceCommand)
+	if err != nil {
+		c.Logger().Errorf("AddStoragePlace handler error: %v", err)
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.NoContent(http.StatusCreated)
+}
+
+func parseEquipment(storagePlace servers.NewStoragePlace) (courier.Equipment, error) {
+	maxWeight := kernel.Weight(0)
+	if storagePlace.MaxWeight != nil {
+		weight, err := kernel.NewWeight(*storagePlace.MaxWeight)
+		if err != nil {
+			return courier.Equipment{}, err
+		}
+		maxWeight = weight
+	}
+	refrigerated := storagePlace.Refrigerated != nil && *storagePlace.Refrigerated
+	fragileSafe := storagePlace.FragileSafe != nil && *storagePlace.FragileSafe
+
+	return courier.NewEquipment(refrigerated, fragileSafe, maxWeight), nil
+}
@@ -3,6 +3,8 @@ package http
 import (
 	"delivery/internal/adapters/in/http/problems"
 	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
 	"errors"
@@ -12,12 +14,31 @@ import (
 )
 
 func (s *Server) CreateCourier(c echo.Context) error {
-	var courier servers.NewCourier
-	if err := c.Bind(&courier); err != nil {
+	var newCourier servers.NewCourier
+	if err := c.Bind(&newCourier); err != nil {
 		return problems.NewBadRequest("invalid request body: " + err.Error())
 	}
 
-	createCourierCommand, err := commands.NewCreateCourierCommand(courier.Name, courier.Speed)
+	var maxPayload *kernel.Weight
+	if newCourier.MaxPayload != nil {
+		weight, err := kernel.NewWeight(*newCourier.MaxPayload)
+		if err != nil {
+			return problems.NewBadRequest(err.Error())
+		}
+		maxPayload = &weight
+	}
+	// Clients which do not know about transport get a car, like the couriers created before
+	transport := courier.TransportCar
+	if newCourier.Transport != nil {
+		transport = courier.Transport(*newCourier.Transport)
+	}
+	// Zero speed means the default speed of the transport
+	speed := 0
+	if newCourier.Speed != nil {
+		speed = *newCourier.Speed
+	}
+
+	createCourierCommand, err := commands.NewCreateCourierCommand(newCourier.Name, transport, speed, maxPayload)
 	if err != nil {
 		return problems.NewBadRequest(err.Error())
 	}
@@ -4,6 +4,7 @@ import (
 	"delivery/internal/adapters/in/http/problems"
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 	"errors"
 	"net/http"
@@ -13,7 +14,19 @@ import (
 )
 
 func (s *Server) CreateOrder(c echo.Context) error {
-	createOrderCommand, err := commands.NewCreateOrderCommand(uuid.New(), "some_street", kernel.Volume(5))
+	address, err := kernel.NewAddress("Россия", "Москва", "Тверская", "1", "1")
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	item, err := order.NewItem(uuid.New(), "Тестовый товар", 10000, 1, 0, false, false)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	createOrderCommand, err := commands.NewCreateOrderCommand(
+		uuid.New(), address, []order.Item{item}, kernel.Volume(5), 0, kernel.DeliveryPeriod{},
+	)
 	if err != nil {
 		return problems.NewBadRequest(err.Error())
 	}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Server) EndShift(c echo.Context, courierId openapi_types.UUID) error {
+	endShiftCommand, err := commands.NewEndShiftCommand(courierId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.endShiftHandler.Handle(c.Request().Context(), endShiftCommand)
+	if err != nil {
+		c.Logger().Errorf("EndShift handler error: %v", err)
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.NoContent(http.StatusOK)
+}
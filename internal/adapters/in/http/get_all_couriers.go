@@ -27,12 +27,16 @@ func (s *Server) GetCouriers(c echo.Context) error {
 		location := servers.Location{
 			X: courier.Location.X,
 			Y: courier.Location.Y,
+			Latitude: courier.Location.Latitude,
+			Longitude: courier.Location.Longitude,
 		}
 
 		var c = servers.Courier{
 			Id: courier.ID,
 			Name: courier.Name,
 			Location: location,
+			Status: servers.CourierStatus(courier.Status),
+			Transport: servers.Transport(courier.Transport),
 		}
 		httpResponse = append(httpResponse, c)
 	}
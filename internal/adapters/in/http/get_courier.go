@@ -0,0 +1,61 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Server) GetCourier(c echo.Context, courierId openapi_types.UUID) error {
+	query, err := queries.NewGetCourierQuery(courierId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	queryResponse, err := s.getCourierHandler.Handle(c.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	storagePlaces := make([]servers.StoragePlace, 0, len(queryResponse.StoragePlaces))
+	for _, place := range queryResponse.StoragePlaces {
+		storagePlaces = append(storagePlaces, servers.StoragePlace{
+			Id:           place.ID,
+			Name:         place.Name,
+			TotalVolume:  place.TotalVolume,
+			Occupied:     place.IsOccupied(),
+			Refrigerated: place.Refrigerated,
+			FragileSafe:  place.FragileSafe,
+			MaxWeight:    place.MaxWeight,
+			OrderId:      place.OrderID,
+		})
+	}
+
+	httpResponse := servers.CourierDetails{
+		Id:         queryResponse.ID,
+		Name:       queryResponse.Name,
+		Status:     servers.CourierStatus(queryResponse.Status),
+		Transport:  servers.Transport(queryResponse.Transport),
+		Speed:      queryResponse.Speed,
+		MaxPayload: queryResponse.MaxPayload,
+		Payload:    queryResponse.Payload(),
+		Location: servers.Location{
+			X:         queryResponse.Location.X,
+			Y:         queryResponse.Location.Y,
+			Latitude:  queryResponse.Location.Latitude,
+			Longitude: queryResponse.Location.Longitude,
+		},
+		StoragePlaces: storagePlaces,
+	}
+
+	return c.JSON(http.StatusOK, httpResponse)
+}
@@ -27,11 +27,22 @@ func (s *Server) GetOrders(c echo.Context) error {
 		location := servers.Location{
 			X: order.Location.X,
 			Y: order.Location.Y,
+			Latitude: order.Location.Latitude,
+			Longitude: order.Location.Longitude,
+		}
+
+		address := servers.Address{
+			Country:   order.Address.Country,
+			City:      order.Address.City,
+			Street:    order.Address.Street,
+			House:     order.Address.House,
+			Apartment: order.Address.Apartment,
 		}
 
 		var o = servers.Order{
 			Id: order.ID,
 			Location: location,
+			Address: address,
 		}
 		httpResponse = append(httpResponse, o)
 	}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Server) GetOrder(c echo.Context, orderId openapi_types.UUID) error {
+	query, err := queries.NewGetOrderQuery(orderId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	queryResponse, err := s.getOrderHandler.Handle(c.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	items := make([]servers.OrderItem, 0, len(queryResponse.Items))
+	for _, item := range queryResponse.Items {
+		items = append(items, servers.OrderItem{
+			GoodId:   item.GoodID,
+			Title:    item.Title,
+			Price:    item.Price,
+			Quantity: item.Quantity,
+		})
+	}
+
+	httpResponse := servers.OrderDetails{
+		Id:     queryResponse.ID,
+		Status: queryResponse.Status,
+		Location: servers.Location{
+			X:         queryResponse.Location.X,
+			Y:         queryResponse.Location.Y,
+			Latitude:  queryResponse.Location.Latitude,
+			Longitude: queryResponse.Location.Longitude,
+		},
+		Address: servers.Address{
+			Country:   queryResponse.Address.Country,
+			City:      queryResponse.Address.City,
+			Street:    queryResponse.Address.Street,
+			House:     queryResponse.Address.House,
+			Apartment: queryResponse.Address.Apartment,
+		},
+		Items:      items,
+		TotalPrice: queryResponse.TotalPrice,
+		Weight:     queryResponse.Weight,
+	}
+
+	return c.JSON(http.StatusOK, httpResponse)
+}
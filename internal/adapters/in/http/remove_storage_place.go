@@ -0,0 +1,32 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Server) RemoveStoragePlace(c echo.Context, courierId openapi_types.UUID,
+	storagePlaceId openapi_types.UUID) error {
+	removeStoragePlaceCommand, err := commands.NewRemoveStoragePlaceCommand(courierId, storagePlaceId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.removeStoragePlaceHandler.Handle(c.Request().Context(), removeStoragePlaceCommand)
+	if err != nil {
+		c.Logger().Errorf("RemoveStoragePlace handler error: %v", err)
+		if errors.Is(err, errs.ErrObjectNotFound) || errors.Is(err, courier.ErrStoragePlaceNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
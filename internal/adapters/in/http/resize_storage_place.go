@@ -0,0 +1,44 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Server) ResizeStoragePlace(c echo.Context, courierId openapi_types.UUID,
+	storagePlaceId openapi_types.UUID) error {
+	var resize servers.ResizeStoragePlace
+	if err := c.Bind(&resize); err != nil {
+		return problems.NewBadRequest("invalid request body: " + err.Error())
+	}
+
+	volume, err := kernel.NewVolume(resize.TotalVolume)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	resizeStoragePlaceCommand, err := commands.NewResizeStoragePlaceCommand(courierId, storagePlaceId, *volume)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.resizeStoragePlaceHandler.Handle(c.Request().Context(), resizeStoragePlaceCommand)
+	if err != nil {
+		c.Logger().Errorf("ResizeStoragePlace handler error: %v", err)
+		if errors.Is(err, errs.ErrObjectNotFound) || errors.Is(err, courier.ErrStoragePlaceNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.NoContent(http.StatusOK)
+}
@@ -8,17 +8,37 @@ import (
 )
 
 type Server struct {
-	createOrderHandler commands.CreateOrderHandler
-	createCourierHandler commands.CreateCourierHandler
-	getAllCouriersHandler queries.GetAllCouriersHandler
+	createOrderHandler         commands.CreateOrderHandler
+	createCourierHandler       commands.CreateCourierHandler
+	cancelOrderHandler         commands.CancelOrderHandler
+	startShiftHandler          commands.StartShiftHandler
+	endShiftHandler            commands.EndShiftHandler
+	updateCourierHandler       commands.UpdateCourierHandler
+	deleteCourierHandler       commands.DeleteCourierHandler
+	addStoragePlaceHandler     commands.AddStoragePlaceHandler
+	removeStoragePlaceHandler  commands.RemoveStoragePlaceHandler
+	resizeStoragePlaceHandler  commands.ResizeStoragePlaceHandler
+	getAllCouriersHandler      queries.GetAllCouriersHandler
+	getCourierHandler          queries.GetCourierHandler
 	getIncompleteOrdersHandler queries.GetIncompleteOrdersHandler
+	getOrderHandler            queries.GetOrderHandler
 }
 
 func NewServer(
 	createOrderHandler commands.CreateOrderHandler,
 	createCourierHandler commands.CreateCourierHandler,
+	cancelOrderHandler commands.CancelOrderHandler,
+	startShiftHandler commands.StartShiftHandler,
+	endShiftHandler commands.EndShiftHandler,
+	updateCourierHandler commands.UpdateCourierHandler,
+	deleteCourierHandler commands.DeleteCourierHandler,
+	addStoragePlaceHandler commands.AddStoragePlaceHandler,
+	removeStoragePlaceHandler commands.RemoveStoragePlaceHandler,
+	resizeStoragePlaceHandler commands.ResizeStoragePlaceHandler,
 	getAllCouriersHandler queries.GetAllCouriersHandler,
+	getCourierHandler queries.GetCourierHandler,
 	getIncompleteOrdersHandler queries.GetIncompleteOrdersHandler,
+	getOrderHandler queries.GetOrderHandler,
 ) (*Server, error) {
 	if createOrderHandler == nil {
 		return nil, errs.NewValueIsRequiredError("createOrderHandler")
@@ -26,17 +46,57 @@ func NewServer(
 	if createCourierHandler == nil {
 		return nil, errs.NewValueIsRequiredError("createCourierHandler")
 	}
+	if cancelOrderHandler == nil {
+		return nil, errs.NewValueIsRequiredError("cancelOrderHandler")
+	}
+	if startShiftHandler == nil {
+		return nil, errs.NewValueIsRequiredError("startShiftHandler")
+	}
+	if endShiftHandler == nil {
+		return nil, errs.NewValueIsRequiredError("endShiftHandler")
+	}
+	if updateCourierHandler == nil {
+		return nil, errs.NewValueIsRequiredError("updateCourierHandler")
+	}
+	if deleteCourierHandler == nil {
+		return nil, errs.NewValueIsRequiredError("deleteCourierHandler")
+	}
+	if addStoragePlaceHandler == nil {
+		return nil, errs.NewValueIsRequiredError("addStoragePlaceHandler")
+	}
+	if removeStoragePlaceHandler == nil {
+		return nil, errs.NewValueIsRequiredError("removeStoragePlaceHandler")
+	}
+	if resizeStoragePlaceHandler == nil {
+		return nil, errs.NewValueIsRequiredError("resizeStoragePlaceHandler")
+	}
 	if getAllCouriersHandler == nil {
 		return nil, errs.NewValueIsRequiredError("getAllCouriersHandler")
 	}
+	if getCourierHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getCourierHandler")
+	}
 	if getIncompleteOrdersHandler == nil {
 		return nil, errs.NewValueIsRequiredError("getIncompleteOrdersHandler")
 	}
+	if getOrderHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getOrderHandler")
+	}
 
 	return &Server{
-		createOrderHandler: createOrderHandler,
-		createCourierHandler: createCourierHandler,
-		getAllCouriersHandler: getAllCouriersHandler,
+		createOrderHandler:         createOrderHandler,
+		createCourierHandler:       createCourierHandler,
+		cancelOrderHandler:         cancelOrderHandler,
+		startShiftHandler:          startShiftHandler,
+		endShiftHandler:            endShiftHandler,
+		updateCourierHandler:       updateCourierHandler,
+		deleteCourierHandler:       deleteCourierHandler,
+		addStoragePlaceHandler:     addStoragePlaceHandler,
+		removeStoragePlaceHandler:  removeStoragePlaceHandler,
+		resizeStoragePlaceHandler:  resizeStoragePlaceHandler,
+		getAllCouriersHandler:      getAllCouriersHandler,
+		getCourierHandler:          getCourierHandler,
 		getIncompleteOrdersHandler: getIncompleteOrdersHandler,
+		getOrderHandler:            getOrderHandler,
 	}, nil
 }
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Server) StartShift(c echo.Context, courierId openapi_types.UUID) error {
+	startShiftCommand, err := commands.NewStartShiftCommand(courierId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.startShiftHandler.Handle(c.Request().Context(), startShiftCommand)
+	if err != nil {
+		c.Logger().Errorf("StartShift handler error: %v", err)
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.NoContent(http.StatusOK)
+}
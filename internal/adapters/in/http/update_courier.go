@@ -0,0 +1,46 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Server) UpdateCourier(c echo.Context, courierId openapi_types.UUID) error {
+	var courier servers.UpdateCourier
+	if err := c.Bind(&courier); err != nil {
+		return problems.NewBadRequest("invalid request body: " + err.Error())
+	}
+
+	var maxPayload *kernel.Weight
+	if courier.MaxPayload != nil {
+		weight, err := kernel.NewWeight(*courier.MaxPayload)
+		if err != nil {
+			return problems.NewBadRequest(err.Error())
+		}
+		maxPayload = &weight
+	}
+
+	updateCourierCommand, err := commands.NewUpdateCourierCommand(courierId, courier.Name, courier.Speed, maxPayload)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.updateCourierHandler.Handle(c.Request().Context(), updateCourierCommand)
+	if err != nil {
+		c.Logger().Errorf("UpdateCourier handler error: %v", err)
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return c.NoContent(http.StatusOK)
+}
@@ -0,0 +1,156 @@
+package kafka
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/generated/queues/basketcancelledpb"
+	"delivery/internal/pkg/errs"
+	"fmt"
+	"log"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+)
+
+type BasketCancelledConsumer interface {
+	Consume() error
+	Close() error
+}
+
+var (
+	_ BasketCancelledConsumer     = &basketCancelledConsumer{}
+	_ sarama.ConsumerGroupHandler = &basketCancelledConsumer{}
+)
+
+type basketCancelledConsumer struct {
+	topic              string
+	consumerGroup      sarama.ConsumerGroup
+	ctx                context.Context
+	cancel             context.CancelFunc
+	cancelOrderHandler commands.CancelOrderHandler
+	decoder            messageDecoder
+	dlq                *deadLetterQueue
+	processor          messageProcessor
+}
+
+func NewBasketCancelledConsumer(
+	brokers []string,
+	group string,
+	topic string,
+	dlqTopic string,
+	retryPolicy RetryPolicy,
+	contentType string,
+	cancelOrderHandler commands.CancelOrderHandler,
+) (BasketCancelledConsumer, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+	if group == "" {
+		return nil, errs.NewValueIsRequiredError("group")
+	}
+	if topic == "" {
+		return nil, errs.NewValueIsRequiredError("topic")
+	}
+	if dlqTopic == "" {
+		return nil, errs.NewValueIsRequiredError("dlqTopic")
+	}
+	if retryPolicy.MaxAttempts() < 1 {
+		return nil, errs.NewValueIsInvalidError("retryPolicy")
+	}
+	if cancelOrderHandler == nil {
+		return nil, errs.NewValueIsRequiredError("cancelOrderHandler")
+	}
+
+	decoder, err := newMessageDecoder(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.Version = sarama.V3_4_0_0
+	saramaCfg.Consumer.Return.Errors = true
+	saramaCfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	consumerGroup, err := sarama.NewConsumerGroup(brokers, group, saramaCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer group: %w", err)
+	}
+
+	dlq, err := newDeadLetterQueue(brokers, dlqTopic)
+	if err != nil {
+		_ = consumerGroup.Close()
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	consumer := &basketCancelledConsumer{
+		topic:              topic,
+		consumerGroup:      consumerGroup,
+		cancelOrderHandler: cancelOrderHandler,
+		decoder:            decoder,
+		dlq:                dlq,
+		ctx:                ctx,
+		cancel:             cancel,
+	}
+	consumer.processor = messageProcessor{
+		command:     "cancelOrder",
+		retryPolicy: retryPolicy,
+		dlq:         dlq,
+		decode:      consumer.decode,
+	}
+	return consumer, nil
+}
+
+func (c *basketCancelledConsumer) Close() error {
+	c.cancel()
+	err := c.consumerGroup.Close()
+	if dlqErr := c.dlq.Close(); err == nil {
+		err = dlqErr
+	}
+	return err
+}
+
+func (c *basketCancelledConsumer) Consume() error {
+	for {
+		err := c.consumerGroup.Consume(c.ctx, []string{c.topic}, c)
+		if err != nil {
+			log.Printf("Error consuming Kafka: %v", err)
+			return err
+		}
+		if c.ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// sarama.ConsumerGroupHandler interface implementation
+func (c *basketCancelledConsumer) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
+func (c *basketCancelledConsumer) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+func (c *basketCancelledConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	return c.processor.ConsumeClaim(session, claim)
+}
+
+func (c *basketCancelledConsumer) decode(message *sarama.ConsumerMessage) (func(ctx context.Context) error, error) {
+	cmd, err := c.readCancelOrderCommand(message)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) error {
+		return c.cancelOrderHandler.Handle(ctx, cmd)
+	}, nil
+}
+
+func (c *basketCancelledConsumer) readCancelOrderCommand(message *sarama.ConsumerMessage) (commands.CancelOrderCommand, error) {
+	var event basketcancelledpb.BasketCancelledIntegrationEvent
+	if err := c.decoder.Decode(message, &event); err != nil {
+		return commands.CancelOrderCommand{}, err
+	}
+
+	basketID, err := uuid.Parse(event.BasketId)
+	if err != nil {
+		return commands.CancelOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("basketId", err)
+	}
+
+	return commands.NewCancelOrderCommand(basketID)
+}
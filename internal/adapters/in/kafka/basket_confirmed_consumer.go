@@ -4,11 +4,13 @@ import (
 	"context"
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/generated/queues/basketconfirmedpb"
 	"delivery/internal/pkg/errs"
-	"encoding/json"
 	"fmt"
 	"log"
+	"math"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/google/uuid"
@@ -30,12 +32,18 @@ type basketConfirmedConsumer struct {
 	ctx                context.Context
 	cancel             context.CancelFunc
 	createOrderHandler commands.CreateOrderHandler
+	decoder            messageDecoder
+	dlq                *deadLetterQueue
+	processor          messageProcessor
 }
 
 func NewBasketConfirmedConsumer(
 	brokers []string,
 	group string,
 	topic string,
+	dlqTopic string,
+	retryPolicy RetryPolicy,
+	contentType string,
 	createOrderHandler commands.CreateOrderHandler,
 ) (BasketConfirmedConsumer, error) {
 	if len(brokers) == 0 {
@@ -47,10 +55,21 @@ func NewBasketConfirmedConsumer(
 	if topic == "" {
 		return nil, errs.NewValueIsRequiredError("topic")
 	}
+	if dlqTopic == "" {
+		return nil, errs.NewValueIsRequiredError("dlqTopic")
+	}
+	if retryPolicy.MaxAttempts() < 1 {
+		return nil, errs.NewValueIsInvalidError("retryPolicy")
+	}
 	if createOrderHandler == nil {
 		return nil, errs.NewValueIsRequiredError("createOrderHandler")
 	}
 
+	decoder, err := newMessageDecoder(contentType)
+	if err != nil {
+		return nil, err
+	}
+
 	saramaCfg := sarama.NewConfig()
 	saramaCfg.Version = sarama.V3_4_0_0
 	saramaCfg.Consumer.Return.Errors = true
@@ -61,20 +80,39 @@ func NewBasketConfirmedConsumer(
 		return nil, fmt.Errorf("failed to create consumer group: %w", err)
 	}
 
+	dlq, err := newDeadLetterQueue(brokers, dlqTopic)
+	if err != nil {
+		_ = consumerGroup.Close()
+		return nil, err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
-	return &basketConfirmedConsumer{
-		topic: topic,
-		consumerGroup: consumerGroup,
+	consumer := &basketConfirmedConsumer{
+		topic:              topic,
+		consumerGroup:      consumerGroup,
 		createOrderHandler: createOrderHandler,
-		ctx: ctx,
-		cancel: cancel,
-	}, nil
+		decoder:            decoder,
+		dlq:                dlq,
+		ctx:                ctx,
+		cancel:             cancel,
+	}
+	consumer.processor = messageProcessor{
+		command:     "createOrder",
+		retryPolicy: retryPolicy,
+		dlq:         dlq,
+		decode:      consumer.decode,
+	}
+	return consumer, nil
 }
 
 func (c *basketConfirmedConsumer) Close() error {
 	c.cancel()
-	return c.consumerGroup.Close()
+	err := c.consumerGroup.Close()
+	if dlqErr := c.dlq.Close(); err == nil {
+		err = dlqErr
+	}
+	return err
 }
 
 func (c *basketConfirmedConsumer) Consume() error {
@@ -94,33 +132,85 @@ func (c *basketConfirmedConsumer) Consume() error {
 func (c *basketConfirmedConsumer) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
 func (c *basketConfirmedConsumer) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
 func (c *basketConfirmedConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	for message := range claim.Messages() {
-		ctx := context.Background()
-		fmt.Printf("Message received: topic = %s, partition = %d, offset = %d, key = %s, value = %s\n",
-			message.Topic, message.Partition, message.Offset, message.Key, message.Value)
-
-		var event basketconfirmedpb.BasketConfirmedIntegrationEvent
-		if err := json.Unmarshal(message.Value, &event); err != nil {
-			log.Printf("Error unmarshalling message: %v", err)
-			session.MarkMessage(message, "")
-			continue
-		}
+	return c.processor.ConsumeClaim(session, claim)
+}
 
-		cmd, err := commands.NewCreateOrderCommand(
-			uuid.MustParse(event.BasketId), event.Address.Street, kernel.Volume(event.Volume),
-		)
+func (c *basketConfirmedConsumer) decode(message *sarama.ConsumerMessage) (func(ctx context.Context) error, error) {
+	cmd, err := c.readCreateOrderCommand(message)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) error {
+		return c.createOrderHandler.Handle(ctx, cmd)
+	}, nil
+}
+
+func (c *basketConfirmedConsumer) readCreateOrderCommand(message *sarama.ConsumerMessage) (commands.CreateOrderCommand, error) {
+	var event basketconfirmedpb.BasketConfirmedIntegrationEvent
+	if err := c.decoder.Decode(message, &event); err != nil {
+		return commands.CreateOrderCommand{}, err
+	}
+
+	basketID, err := uuid.Parse(event.BasketId)
+	if err != nil {
+		return commands.CreateOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("basketId", err)
+	}
+
+	deliveryPeriod, err := readDeliveryPeriod(event.DeliveryPeriod, message.Timestamp)
+	if err != nil {
+		return commands.CreateOrderCommand{}, err
+	}
+
+	address, err := readAddress(event.Address)
+	if err != nil {
+		return commands.CreateOrderCommand{}, err
+	}
+
+	items, err := readItems(event.Items)
+	if err != nil {
+		return commands.CreateOrderCommand{}, err
+	}
+
+	return commands.NewCreateOrderCommand(
+		basketID, address, items, kernel.Volume(event.Volume), kernel.Weight(event.Weight), deliveryPeriod,
+	)
+}
+
+func readAddress(address *basketconfirmedpb.Address) (kernel.Address, error) {
+	if address == nil {
+		return kernel.Address{}, errs.NewValueIsRequiredError("address")
+	}
+	return kernel.NewAddress(address.Country, address.City, address.Street, address.House, address.Apartment)
+}
+
+// readItems converts prices to minor currency units, so that totals add up exactly. Weights are in grams already
+func readItems(basketItems []*basketconfirmedpb.Item) ([]order.Item, error) {
+	items := make([]order.Item, 0, len(basketItems))
+	for _, basketItem := range basketItems {
+		goodID, err := uuid.Parse(basketItem.GoodId)
 		if err != nil {
-			log.Printf("Error creating createOrder command: %v", err)
-			session.MarkMessage(message, "")
-			continue
+			return nil, errs.NewValueIsInvalidErrorWithCause("goodId", err)
 		}
-
-		if err := c.createOrderHandler.Handle(ctx, cmd); err != nil {
-			log.Printf("Error handling createOrder command: %v", err)
+		item, err := order.NewItem(
+			goodID, basketItem.Title, int64(math.Round(basketItem.Price*100)), int(basketItem.Quantity),
+			kernel.Weight(basketItem.Weight), basketItem.Frozen, basketItem.Fragile,
+		)
+		if err != nil {
+			return nil, err
 		}
-
-		session.MarkMessage(message, "")
+		items = append(items, item)
 	}
+	return items, nil
+}
 
-	return nil
+// readDeliveryPeriod places the checkout slot on the calendar relative to the moment the basket was confirmed.
+// Missing slot means the customer accepts any time
+func readDeliveryPeriod(slot *basketconfirmedpb.DeliveryPeriod, confirmedAt time.Time) (kernel.DeliveryPeriod, error) {
+	if slot == nil || (slot.From == 0 && slot.To == 0) {
+		return kernel.DeliveryPeriod{}, nil
+	}
+	if confirmedAt.IsZero() {
+		confirmedAt = time.Now()
+	}
+	return kernel.NewDeliveryPeriodFromSlot(int(slot.From), int(slot.To), confirmedAt)
 }
@@ -0,0 +1,102 @@
+package kafka
+
+import (
+	"delivery/internal/pkg/errs"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// Headers added to a message when it is sent to the dead letter topic,
+// the original headers are kept as they are
+const (
+	HeaderDeadLetterError     = "x-dlq-error"
+	HeaderDeadLetterTopic     = "x-dlq-original-topic"
+	HeaderDeadLetterPartition = "x-dlq-original-partition"
+	HeaderDeadLetterOffset    = "x-dlq-original-offset"
+	HeaderDeadLetterFailedAt  = "x-dlq-failed-at"
+
+	headerDeadLetterPrefix = "x-dlq-"
+)
+
+// deadLetterQueue keeps messages the consumer could not handle, so that they can be replayed later
+type deadLetterQueue struct {
+	topic    string
+	producer sarama.SyncProducer
+}
+
+func newDeadLetterQueue(brokers []string, topic string) (*deadLetterQueue, error) {
+	if topic == "" {
+		return nil, errs.NewValueIsRequiredError("dlqTopic")
+	}
+
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.Version = sarama.V3_4_0_0
+	saramaCfg.Producer.RequiredAcks = sarama.WaitForAll
+	saramaCfg.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(brokers, saramaCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dead letter producer: %w", err)
+	}
+
+	return &deadLetterQueue{
+		topic:    topic,
+		producer: producer,
+	}, nil
+}
+
+func (q *deadLetterQueue) Send(message *sarama.ConsumerMessage, cause error) error {
+	headers := make([]sarama.RecordHeader, 0, len(message.Headers)+5)
+	for _, header := range message.Headers {
+		if header != nil {
+			headers = append(headers, *header)
+		}
+	}
+	headers = append(headers,
+		sarama.RecordHeader{Key: []byte(HeaderDeadLetterError), Value: []byte(cause.Error())},
+		sarama.RecordHeader{Key: []byte(HeaderDeadLetterTopic), Value: []byte(message.Topic)},
+		sarama.RecordHeader{Key: []byte(HeaderDeadLetterPartition), Value: []byte(strconv.Itoa(int(message.Partition)))},
+		sarama.RecordHeader{Key: []byte(HeaderDeadLetterOffset), Value: []byte(strconv.FormatInt(message.Offset, 10))},
+		sarama.RecordHeader{Key: []byte(HeaderDeadLetterFailedAt), Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+	)
+
+	_, _, err := q.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   q.topic,
+		Key:     sarama.ByteEncoder(message.Key),
+		Value:   sarama.ByteEncoder(message.Value),
+		Headers: headers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send message to dead letter topic: %w", err)
+	}
+	return nil
+}
+
+func (q *deadLetterQueue) Close() error {
+	return q.producer.Close()
+}
+
+// originalHeaders drops the headers added by the dead letter queue
+func originalHeaders(headers []*sarama.RecordHeader) []sarama.RecordHeader {
+	original := make([]sarama.RecordHeader, 0, len(headers))
+	for _, header := range headers {
+		if header == nil || strings.HasPrefix(string(header.Key), headerDeadLetterPrefix) {
+			continue
+		}
+		original = append(original, *header)
+	}
+	return original
+}
+
+func headerValue(headers []*sarama.RecordHeader, key string) string {
+	for _, header := range headers {
+		if header != nil && string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
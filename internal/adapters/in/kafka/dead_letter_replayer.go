@@ -0,0 +1,185 @@
+package kafka
+
+import (
+	"context"
+	"delivery/internal/pkg/errs"
+	"fmt"
+	"log"
+
+	"github.com/IBM/sarama"
+)
+
+type DeadLetterReplayer interface {
+	Replay(ctx context.Context) (int, error)
+	Close() error
+}
+
+var _ DeadLetterReplayer = &deadLetterReplayer{}
+
+// deadLetterReplayer sends messages from the dead letter topic back to the topic they came from.
+// Replayed offsets are committed for the group, so every message is replayed once
+type deadLetterReplayer struct {
+	dlqTopic     string
+	defaultTopic string
+	client       sarama.Client
+	consumer     sarama.Consumer
+	producer     sarama.SyncProducer
+	offsets      sarama.OffsetManager
+}
+
+func NewDeadLetterReplayer(brokers []string, group, dlqTopic, defaultTopic string) (DeadLetterReplayer, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+	if group == "" {
+		return nil, errs.NewValueIsRequiredError("group")
+	}
+	if dlqTopic == "" {
+		return nil, errs.NewValueIsRequiredError("dlqTopic")
+	}
+	if defaultTopic == "" {
+		return nil, errs.NewValueIsRequiredError("defaultTopic")
+	}
+
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.Version = sarama.V3_4_0_0
+	saramaCfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+	saramaCfg.Producer.RequiredAcks = sarama.WaitForAll
+	saramaCfg.Producer.Return.Successes = true
+
+	client, err := sarama.NewClient(brokers, saramaCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to create consumer: %w", err)
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		_ = consumer.Close()
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to create sync producer: %w", err)
+	}
+	offsets, err := sarama.NewOffsetManagerFromClient(group, client)
+	if err != nil {
+		_ = producer.Close()
+		_ = consumer.Close()
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to create offset manager: %w", err)
+	}
+
+	return &deadLetterReplayer{
+		dlqTopic:     dlqTopic,
+		defaultTopic: defaultTopic,
+		client:       client,
+		consumer:     consumer,
+		producer:     producer,
+		offsets:      offsets,
+	}, nil
+}
+
+// Replay goes through the messages which were in the dead letter topic when it started
+// and returns how many of them were sent back
+func (r *deadLetterReplayer) Replay(ctx context.Context) (int, error) {
+	partitions, err := r.client.Partitions(r.dlqTopic)
+	if err != nil {
+		return 0, err
+	}
+
+	replayed := 0
+	for _, partition := range partitions {
+		n, err := r.replayPartition(ctx, partition)
+		replayed += n
+		if err != nil {
+			return replayed, err
+		}
+	}
+	return replayed, nil
+}
+
+func (r *deadLetterReplayer) replayPartition(ctx context.Context, partition int32) (int, error) {
+	newest, err := r.client.GetOffset(r.dlqTopic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, err
+	}
+	partitionOffsets, err := r.offsets.ManagePartition(r.dlqTopic, partition)
+	if err != nil {
+		return 0, err
+	}
+	defer partitionOffsets.AsyncClose()
+
+	next, _ := partitionOffsets.NextOffset()
+	if next == sarama.OffsetOldest {
+		next, err = r.client.GetOffset(r.dlqTopic, partition, sarama.OffsetOldest)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if next >= newest {
+		return 0, nil
+	}
+
+	partitionConsumer, err := r.consumer.ConsumePartition(r.dlqTopic, partition, next)
+	if err != nil {
+		return 0, err
+	}
+	defer partitionConsumer.AsyncClose()
+
+	replayed := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return replayed, ctx.Err()
+		case message, ok := <-partitionConsumer.Messages():
+			if !ok {
+				return replayed, nil
+			}
+			err := r.replayMessage(message)
+			if err != nil {
+				return replayed, err
+			}
+			partitionOffsets.MarkOffset(message.Offset+1, "")
+			replayed++
+			if message.Offset+1 >= newest {
+				return replayed, nil
+			}
+		}
+	}
+}
+
+func (r *deadLetterReplayer) replayMessage(message *sarama.ConsumerMessage) error {
+	topic := headerValue(message.Headers, HeaderDeadLetterTopic)
+	if topic == "" {
+		topic = r.defaultTopic
+	}
+	log.Printf("Replaying message: partition = %d, offset = %d, topic = %s, error = %s",
+		message.Partition, message.Offset, topic, headerValue(message.Headers, HeaderDeadLetterError))
+
+	_, _, err := r.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(message.Key),
+		Value:   sarama.ByteEncoder(message.Value),
+		Headers: originalHeaders(message.Headers),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replay message: %w", err)
+	}
+	return nil
+}
+
+// Close commits replayed offsets
+func (r *deadLetterReplayer) Close() error {
+	err := r.offsets.Close()
+	if producerErr := r.producer.Close(); err == nil {
+		err = producerErr
+	}
+	if consumerErr := r.consumer.Close(); err == nil {
+		err = consumerErr
+	}
+	if clientErr := r.client.Close(); err == nil {
+		err = clientErr
+	}
+	return err
+}
@@ -0,0 +1,27 @@
+package kafka
+
+import (
+	"context"
+	"delivery/internal/pkg/inbox"
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// HeaderEventID identifies an integration event across redeliveries and replays.
+// Without it the event is identified by its position in the topic
+const HeaderEventID = "event-id"
+
+// withInboxMessage makes the unit of work record the message on commit,
+// so that the message is applied exactly once
+func withInboxMessage(ctx context.Context, message *sarama.ConsumerMessage) (context.Context, error) {
+	eventID := headerValue(message.Headers, HeaderEventID)
+	if eventID == "" {
+		eventID = fmt.Sprintf("%d/%d", message.Partition, message.Offset)
+	}
+	inboxMessage, err := inbox.NewMessage(message.Topic, message.Partition, message.Offset, eventID)
+	if err != nil {
+		return ctx, err
+	}
+	return inbox.WithMessage(ctx, inboxMessage), nil
+}
@@ -0,0 +1,82 @@
+package kafka
+
+import (
+	"delivery/internal/pkg/errs"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Content types of integration messages, taken from the content-type header
+// or from the consumer config when the producer does not send the header
+const (
+	ContentTypeJSON      = "application/json"
+	ContentTypeProtobuf  = "application/x-protobuf"
+	ContentTypeProtoJSON = "application/x-protojson"
+
+	HeaderContentType = "content-type"
+)
+
+// messageDecoder turns a message into the generated protobuf type according to its content type
+type messageDecoder struct {
+	defaultContentType string
+}
+
+func newMessageDecoder(defaultContentType string) (messageDecoder, error) {
+	if defaultContentType == "" {
+		defaultContentType = ContentTypeJSON
+	}
+	contentType, err := parseContentType(defaultContentType)
+	if err != nil {
+		return messageDecoder{}, err
+	}
+	return messageDecoder{defaultContentType: contentType}, nil
+}
+
+func (d messageDecoder) Decode(message *sarama.ConsumerMessage, event proto.Message) error {
+	contentType := d.defaultContentType
+	for _, header := range message.Headers {
+		if header != nil && strings.EqualFold(string(header.Key), HeaderContentType) {
+			var err error
+			contentType, err = parseContentType(string(header.Value))
+			if err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	var err error
+	switch contentType {
+	case ContentTypeProtobuf:
+		err = proto.Unmarshal(message.Value, event)
+	case ContentTypeProtoJSON:
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(message.Value, event)
+	default:
+		err = json.Unmarshal(message.Value, event)
+	}
+	if err != nil {
+		return errs.NewValueIsInvalidErrorWithCause("message", fmt.Errorf("%s: %w", contentType, err))
+	}
+	return nil
+}
+
+// parseContentType drops parameters such as charset, "application/protobuf" is accepted as an alias
+func parseContentType(raw string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(raw)
+	if err != nil {
+		return "", errs.NewValueIsInvalidErrorWithCause("contentType", err)
+	}
+	switch mediaType {
+	case ContentTypeJSON, ContentTypeProtobuf, ContentTypeProtoJSON:
+		return mediaType, nil
+	case "application/protobuf":
+		return ContentTypeProtobuf, nil
+	}
+	return "", errs.NewValueIsInvalidError("contentType")
+}
@@ -0,0 +1,150 @@
+package kafka
+
+import (
+	"delivery/internal/generated/queues/basketcancelledpb"
+	"delivery/internal/pkg/errs"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const testBasketID = "0196d2d5-5b9e-7d1a-9c6f-3f2a1b4c5d6e"
+
+func newDecoderTestMessage(value []byte, contentType string) *sarama.ConsumerMessage {
+	message := &sarama.ConsumerMessage{Topic: "basket.cancelled", Value: value}
+	if contentType != "" {
+		message.Headers = []*sarama.RecordHeader{{Key: []byte("Content-Type"), Value: []byte(contentType)}}
+	}
+	return message
+}
+
+func Test_ParseContentType(t *testing.T) {
+	tests := map[string]struct {
+		raw      string
+		expected string
+	}{
+		"json":              {raw: "application/json", expected: ContentTypeJSON},
+		"json_with_charset": {raw: "application/json; charset=utf-8", expected: ContentTypeJSON},
+		"protobuf":          {raw: "application/x-protobuf", expected: ContentTypeProtobuf},
+		"protobuf_alias":    {raw: "application/protobuf", expected: ContentTypeProtobuf},
+		"protojson":         {raw: "application/x-protojson", expected: ContentTypeProtoJSON},
+		"upper_case":        {raw: "Application/JSON", expected: ContentTypeJSON},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			contentType, err := parseContentType(test.raw)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, contentType)
+		})
+	}
+}
+
+func Test_ParseContentTypeErrorUnknownType(t *testing.T) {
+	for _, raw := range []string{"text/plain", "application/xml", "not a media type", ""} {
+		t.Run(raw, func(t *testing.T) {
+			// Act
+			_, err := parseContentType(raw)
+
+			// Assert
+			assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+			assert.True(t, isPoison(err), "message with unknown content type should go to the dead letter topic")
+		})
+	}
+}
+
+func Test_MessageDecoderDecodes(t *testing.T) {
+	event := &basketcancelledpb.BasketCancelledIntegrationEvent{BasketId: testBasketID, Reason: "changed mind"}
+	binary, _ := proto.Marshal(event)
+	protoJSON, _ := protojson.Marshal(event)
+	tests := map[string]struct {
+		value       []byte
+		contentType string
+	}{
+		"json":      {value: []byte(`{"basketId":"` + testBasketID + `","reason":"changed mind"}`), contentType: ContentTypeJSON},
+		"protobuf":  {value: binary, contentType: ContentTypeProtobuf},
+		"protojson": {value: protoJSON, contentType: ContentTypeProtoJSON},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			decoder, _ := newMessageDecoder("")
+
+			// Act
+			var decoded basketcancelledpb.BasketCancelledIntegrationEvent
+			err := decoder.Decode(newDecoderTestMessage(test.value, test.contentType), &decoded)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, testBasketID, decoded.BasketId)
+			assert.Equal(t, "changed mind", decoded.Reason)
+		})
+	}
+}
+
+func Test_MessageDecoderUsesDefaultWithoutHeader(t *testing.T) {
+	// Arrange
+	binary, _ := proto.Marshal(&basketcancelledpb.BasketCancelledIntegrationEvent{BasketId: testBasketID})
+	decoder, _ := newMessageDecoder("application/protobuf")
+
+	// Act
+	var decoded basketcancelledpb.BasketCancelledIntegrationEvent
+	err := decoder.Decode(newDecoderTestMessage(binary, ""), &decoded)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, testBasketID, decoded.BasketId)
+}
+
+func Test_MessageDecoderPrefersHeaderOverDefault(t *testing.T) {
+	// Arrange
+	decoder, _ := newMessageDecoder(ContentTypeProtobuf)
+	value := []byte(`{"basketId":"` + testBasketID + `"}`)
+
+	// Act
+	var decoded basketcancelledpb.BasketCancelledIntegrationEvent
+	err := decoder.Decode(newDecoderTestMessage(value, "application/json; charset=utf-8"), &decoded)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, testBasketID, decoded.BasketId)
+}
+
+func Test_MessageDecoderErrorUnknownHeader(t *testing.T) {
+	// Arrange
+	decoder, _ := newMessageDecoder(ContentTypeJSON)
+
+	// Act
+	var decoded basketcancelledpb.BasketCancelledIntegrationEvent
+	err := decoder.Decode(newDecoderTestMessage([]byte(`{}`), "text/csv"), &decoded)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func Test_MessageDecoderErrorMalformedValue(t *testing.T) {
+	// Arrange
+	decoder, _ := newMessageDecoder(ContentTypeJSON)
+
+	// Act
+	var decoded basketcancelledpb.BasketCancelledIntegrationEvent
+	err := decoder.Decode(newDecoderTestMessage([]byte(`{"basketId":`), ""), &decoded)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid, "malformed message should be poison")
+}
+
+func Test_NewMessageDecoderErrorUnknownDefault(t *testing.T) {
+	// Act
+	_, err := newMessageDecoder("text/plain")
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
@@ -0,0 +1,95 @@
+package kafka
+
+import (
+	"context"
+	"delivery/internal/pkg/inbox"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// decodeFunc reads the command from a message and returns the call that handles it
+type decodeFunc func(message *sarama.ConsumerMessage) (func(ctx context.Context) error, error)
+
+// deadLetterSender keeps a message the consumer could not handle
+type deadLetterSender interface {
+	Send(message *sarama.ConsumerMessage, cause error) error
+}
+
+// messageProcessor is the part shared by the consumers: a message is recorded in the inbox,
+// transient errors are retried with backoff, and what still fails goes to the dead letter topic
+type messageProcessor struct {
+	command     string
+	retryPolicy RetryPolicy
+	dlq         deadLetterSender
+	decode      decodeFunc
+}
+
+func (p messageProcessor) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for message := range claim.Messages() {
+		ctx := session.Context()
+		log.Printf("Message received: topic = %s, partition = %d, offset = %d",
+			message.Topic, message.Partition, message.Offset)
+
+		done, err := p.process(ctx, message)
+		if err != nil {
+			return err
+		}
+		if !done {
+			// Session is over, the message will be consumed again by the next owner of the partition
+			return nil
+		}
+
+		session.MarkMessage(message, "")
+	}
+
+	return nil
+}
+
+// process tells whether the message can be marked consumed, either handled or sent to the dead letter topic
+func (p messageProcessor) process(ctx context.Context, message *sarama.ConsumerMessage) (bool, error) {
+	err := p.consume(ctx, message)
+	if err != nil && ctx.Err() != nil {
+		return false, nil
+	}
+	if err != nil {
+		log.Printf("Sending message to dead letter topic: %v", err)
+		if err := p.dlq.Send(message, err); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// consume retries transient errors, such as a geo service timeout, with backoff.
+// Malformed messages are not retried
+func (p messageProcessor) consume(ctx context.Context, message *sarama.ConsumerMessage) error {
+	handle, err := p.decode(message)
+	if err != nil {
+		return err
+	}
+	ctx, err = withInboxMessage(ctx, message)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = handle(ctx)
+		if errors.Is(err, inbox.ErrAlreadyProcessed) {
+			log.Printf("Skipping message: %v", err)
+			return nil
+		}
+		if err == nil || isPoison(err) || attempt >= p.retryPolicy.MaxAttempts() {
+			return err
+		}
+		delay := p.retryPolicy.Delay(attempt)
+		log.Printf("Error handling %s command, attempt %d, retrying in %s: %v", p.command, attempt, delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package kafka
+
+import (
+	"context"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/inbox"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeDeadLetterQueue struct {
+	sent  []*sarama.ConsumerMessage
+	cause error
+	err   error
+}
+
+func (q *fakeDeadLetterQueue) Send(message *sarama.ConsumerMessage, cause error) error {
+	if q.err != nil {
+		return q.err
+	}
+	q.sent = append(q.sent, message)
+	q.cause = cause
+	return nil
+}
+
+// fakeCommandHandler fails with the queued errors first and succeeds afterwards
+type fakeCommandHandler struct {
+	errs  []error
+	calls int
+}
+
+func (h *fakeCommandHandler) Handle(ctx context.Context) error {
+	h.calls++
+	if _, ok := inbox.MessageFromContext(ctx); !ok {
+		return errors.New("inbox message is not set")
+	}
+	if len(h.errs) == 0 {
+		return nil
+	}
+	err := h.errs[0]
+	h.errs = h.errs[1:]
+	return err
+}
+
+func newTestProcessor(handler *fakeCommandHandler, dlq *fakeDeadLetterQueue, decodeErr error) messageProcessor {
+	policy, _ := NewRetryPolicy(3, 0, 0)
+	return messageProcessor{
+		command:     "test",
+		retryPolicy: policy,
+		dlq:         dlq,
+		decode: func(*sarama.ConsumerMessage) (func(ctx context.Context) error, error) {
+			if decodeErr != nil {
+				return nil, decodeErr
+			}
+			return handler.Handle, nil
+		},
+	}
+}
+
+func newTestMessage() *sarama.ConsumerMessage {
+	return &sarama.ConsumerMessage{Topic: "basket.confirmed", Partition: 1, Offset: 42}
+}
+
+func Test_MessageProcessorHandlesMessage(t *testing.T) {
+	// Arrange
+	handler := &fakeCommandHandler{}
+	dlq := &fakeDeadLetterQueue{}
+	processor := newTestProcessor(handler, dlq, nil)
+
+	// Act
+	done, err := processor.process(context.Background(), newTestMessage())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, 1, handler.calls)
+	assert.Empty(t, dlq.sent)
+}
+
+func Test_MessageProcessorRetriesTransientErrors(t *testing.T) {
+	// Arrange
+	transient := errors.New("geo service timeout")
+	handler := &fakeCommandHandler{errs: []error{transient, transient}}
+	dlq := &fakeDeadLetterQueue{}
+	processor := newTestProcessor(handler, dlq, nil)
+
+	// Act
+	done, err := processor.process(context.Background(), newTestMessage())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, 3, handler.calls, "third attempt should succeed")
+	assert.Empty(t, dlq.sent)
+}
+
+func Test_MessageProcessorSendsToDeadLetterAfterMaxAttempts(t *testing.T) {
+	// Arrange
+	transient := errors.New("geo service timeout")
+	handler := &fakeCommandHandler{errs: []error{transient, transient, transient}}
+	dlq := &fakeDeadLetterQueue{}
+	processor := newTestProcessor(handler, dlq, nil)
+
+	// Act
+	done, err := processor.process(context.Background(), newTestMessage())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, done, "message in the dead letter topic should be marked consumed")
+	assert.Equal(t, 3, handler.calls)
+	assert.Len(t, dlq.sent, 1)
+	assert.ErrorIs(t, dlq.cause, transient)
+}
+
+func Test_MessageProcessorSendsPoisonToDeadLetterAtOnce(t *testing.T) {
+	// Arrange
+	handler := &fakeCommandHandler{errs: []error{errs.NewValueIsInvalidError("volume")}}
+	dlq := &fakeDeadLetterQueue{}
+	processor := newTestProcessor(handler, dlq, nil)
+
+	// Act
+	done, err := processor.process(context.Background(), newTestMessage())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, 1, handler.calls, "poison message should not be retried")
+	assert.Len(t, dlq.sent, 1)
+}
+
+func Test_MessageProcessorSendsUndecodableMessageToDeadLetter(t *testing.T) {
+	// Arrange
+	handler := &fakeCommandHandler{}
+	dlq := &fakeDeadLetterQueue{}
+	processor := newTestProcessor(handler, dlq, errs.NewValueIsInvalidError("message"))
+
+	// Act
+	done, err := processor.process(context.Background(), newTestMessage())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Zero(t, handler.calls)
+	assert.Len(t, dlq.sent, 1)
+}
+
+func Test_MessageProcessorSkipsAlreadyProcessedMessage(t *testing.T) {
+	// Arrange
+	handler := &fakeCommandHandler{errs: []error{inbox.ErrAlreadyProcessed}}
+	dlq := &fakeDeadLetterQueue{}
+	processor := newTestProcessor(handler, dlq, nil)
+
+	// Act
+	done, err := processor.process(context.Background(), newTestMessage())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, 1, handler.calls)
+	assert.Empty(t, dlq.sent)
+}
+
+func Test_MessageProcessorLeavesMessageWhenSessionIsOver(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	transient := errors.New("geo service timeout")
+	handler := &fakeCommandHandler{errs: []error{transient, transient, transient}}
+	dlq := &fakeDeadLetterQueue{}
+	processor := newTestProcessor(handler, dlq, nil)
+
+	// Act
+	done, err := processor.process(ctx, newTestMessage())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, done, "message should be consumed again by the next owner of the partition")
+	assert.Empty(t, dlq.sent)
+}
+
+func Test_MessageProcessorFailsWhenDeadLetterIsUnavailable(t *testing.T) {
+	// Arrange
+	handler := &fakeCommandHandler{errs: []error{errs.NewValueIsInvalidError("volume")}}
+	dlq := &fakeDeadLetterQueue{err: errors.New("broker is down")}
+	processor := newTestProcessor(handler, dlq, nil)
+
+	// Act
+	done, err := processor.process(context.Background(), newTestMessage())
+
+	// Assert
+	assert.Error(t, err)
+	assert.False(t, done, "message should not be marked consumed when it is kept nowhere")
+}
@@ -0,0 +1,52 @@
+package kafka
+
+import (
+	"delivery/internal/pkg/errs"
+	"errors"
+	"time"
+)
+
+// RetryPolicy tells how many times a message is handled before it goes to the dead letter topic
+// and how long to wait between the attempts. The wait doubles every attempt up to MaxBackoff
+type RetryPolicy struct {
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+}
+
+func NewRetryPolicy(maxAttempts int, backoff, maxBackoff time.Duration) (RetryPolicy, error) {
+	if maxAttempts < 1 {
+		return RetryPolicy{}, errs.NewValueIsInvalidError("maxAttempts")
+	}
+	if backoff < 0 {
+		return RetryPolicy{}, errs.NewValueIsInvalidError("backoff")
+	}
+	if maxBackoff < backoff {
+		return RetryPolicy{}, errs.NewValueIsInvalidError("maxBackoff")
+	}
+	return RetryPolicy{
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		maxBackoff:  maxBackoff,
+	}, nil
+}
+
+func (p RetryPolicy) MaxAttempts() int {
+	return p.maxAttempts
+}
+
+// Delay is the wait after the given failed attempt, counting from 1
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	delay := p.backoff
+	for i := 1; i < attempt && delay < p.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, p.maxBackoff)
+}
+
+// isPoison tells errors which will not go away on retry, such as a malformed message
+func isPoison(err error) bool {
+	return errors.Is(err, errs.ErrValueIsInvalid) ||
+		errors.Is(err, errs.ErrValueIsRequired) ||
+		errors.Is(err, errs.ErrValueIsOutOfRange)
+}
@@ -0,0 +1,82 @@
+package kafka
+
+import (
+	"delivery/internal/pkg/errs"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewRetryPolicy(t *testing.T) {
+	tests := map[string]struct {
+		maxAttempts int
+		backoff     time.Duration
+		maxBackoff  time.Duration
+		valid       bool
+	}{
+		"ok":                    {maxAttempts: 3, backoff: time.Second, maxBackoff: time.Minute, valid: true},
+		"no_backoff":            {maxAttempts: 1, backoff: 0, maxBackoff: 0, valid: true},
+		"no_attempts":           {maxAttempts: 0, backoff: time.Second, maxBackoff: time.Minute},
+		"negative_backoff":      {maxAttempts: 3, backoff: -time.Second, maxBackoff: time.Minute},
+		"max_less_than_backoff": {maxAttempts: 3, backoff: time.Minute, maxBackoff: time.Second},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			policy, err := NewRetryPolicy(test.maxAttempts, test.backoff, test.maxBackoff)
+
+			// Assert
+			if test.valid {
+				assert.NoError(t, err)
+				assert.Equal(t, test.maxAttempts, policy.MaxAttempts())
+			} else {
+				assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+			}
+		})
+	}
+}
+
+func Test_RetryPolicyDelayDoublesUpToMaxBackoff(t *testing.T) {
+	// Arrange
+	policy, _ := NewRetryPolicy(10, 100*time.Millisecond, time.Second)
+
+	// Act
+	var delays []time.Duration
+	for attempt := 1; attempt <= 6; attempt++ {
+		delays = append(delays, policy.Delay(attempt))
+	}
+
+	// Assert
+	assert.Equal(t, []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}, delays)
+}
+
+func Test_IsPoison(t *testing.T) {
+	tests := map[string]struct {
+		err    error
+		poison bool
+	}{
+		"invalid":      {err: errs.NewValueIsInvalidError("basketId"), poison: true},
+		"required":     {err: errs.NewValueIsRequiredError("address"), poison: true},
+		"out_of_range": {err: errs.NewValueIsOutOfRangeError("x", 0, 1, 10), poison: true},
+		"wrapped":      {err: fmt.Errorf("decode: %w", errs.NewValueIsInvalidError("message")), poison: true},
+		"transient":    {err: errors.New("geo service timeout")},
+		"not_found":    {err: errs.NewObjectNotFoundError("order", "1")},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.poison, isPoison(test.err))
+		})
+	}
+}
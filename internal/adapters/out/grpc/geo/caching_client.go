@@ -0,0 +1,130 @@
+package geo
+
+import (
+	"container/list"
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"sync"
+	"time"
+)
+
+var _ ports.GeoClient = &CachingClient{}
+
+// CacheStats is how well the cache saves calls to the geo service
+type CacheStats struct {
+	Size      int   `json:"size"`
+	Hits      int64 `json:"hits"`
+	Misses    int64 `json:"misses"`
+	Evictions int64 `json:"evictions"`
+}
+
+type cacheEntry struct {
+	key       string
+	location  kernel.Location
+	expiresAt time.Time
+}
+
+// CachingClient keeps recently geocoded addresses. The least recently used address
+// is dropped when the cache is full, and an address is geocoded again after ttl
+type CachingClient struct {
+	next     ports.GeoClient
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	recent  *list.List
+	stats   CacheStats
+}
+
+func NewCachingClient(next ports.GeoClient, capacity int, ttl time.Duration, now func() time.Time) (*CachingClient, error) {
+	if next == nil {
+		return nil, errs.NewValueIsRequiredError("next")
+	}
+	if capacity < 1 {
+		return nil, errs.NewValueIsInvalidError("capacity")
+	}
+	if ttl <= 0 {
+		return nil, errs.NewValueIsInvalidError("ttl")
+	}
+	if now == nil {
+		return nil, errs.NewValueIsRequiredError("now")
+	}
+
+	return &CachingClient{
+		next:     next,
+		capacity: capacity,
+		ttl:      ttl,
+		now:      now,
+		entries:  make(map[string]*list.Element, capacity),
+		recent:   list.New(),
+	}, nil
+}
+
+func (c *CachingClient) GetGeoLocation(ctx context.Context, address kernel.Address) (kernel.Location, error) {
+	key := address.String()
+	if location, ok := c.get(key); ok {
+		return location, nil
+	}
+
+	location, err := c.next.GetGeoLocation(ctx, address)
+	if err != nil {
+		return kernel.Location{}, err
+	}
+	c.put(key, location)
+	return location, nil
+}
+
+func (c *CachingClient) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Size = c.recent.Len()
+	return stats
+}
+
+func (c *CachingClient) get(key string) (kernel.Location, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return kernel.Location{}, false
+	}
+	entry := element.Value.(*cacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.recent.Remove(element)
+		delete(c.entries, key)
+		c.stats.Misses++
+		return kernel.Location{}, false
+	}
+	c.recent.MoveToFront(element)
+	c.stats.Hits++
+	return entry.location, true
+}
+
+func (c *CachingClient) put(key string, location kernel.Location) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(c.ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*cacheEntry)
+		entry.location, entry.expiresAt = location, expiresAt
+		c.recent.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.recent.PushFront(&cacheEntry{key: key, location: location, expiresAt: expiresAt})
+	if c.recent.Len() > c.capacity {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		c.stats.Evictions++
+	}
+}
@@ -0,0 +1,86 @@
+package geo
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CachingClientGeocodesAddressOnce(t *testing.T) {
+	// Arrange
+	next := &fakeGeoClient{location: kernel.MaxLocation()}
+	clock := &fakeClock{current: time.Now()}
+	client, _ := NewCachingClient(next, 2, time.Minute, clock.now)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+
+	// Act
+	_, _ = client.GetGeoLocation(context.Background(), address)
+	location, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, kernel.MaxLocation(), location)
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, CacheStats{Size: 1, Hits: 1, Misses: 1}, client.Stats())
+}
+
+func Test_CachingClientGeocodesAgainAfterTtl(t *testing.T) {
+	// Arrange
+	next := &fakeGeoClient{location: kernel.MaxLocation()}
+	clock := &fakeClock{current: time.Now()}
+	client, _ := NewCachingClient(next, 2, time.Minute, clock.now)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+	_, _ = client.GetGeoLocation(context.Background(), address)
+
+	// Act
+	clock.current = clock.current.Add(time.Minute)
+	_, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, next.calls)
+}
+
+func Test_CachingClientEvictsLeastRecentlyUsedAddress(t *testing.T) {
+	// Arrange
+	next := &fakeGeoClient{location: kernel.MaxLocation()}
+	clock := &fakeClock{current: time.Now()}
+	client, _ := NewCachingClient(next, 2, time.Minute, clock.now)
+	first := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+	second := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "2", "")
+	third := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "3", "")
+	_, _ = client.GetGeoLocation(context.Background(), first)
+	_, _ = client.GetGeoLocation(context.Background(), second)
+	_, _ = client.GetGeoLocation(context.Background(), first)
+
+	// Act
+	_, _ = client.GetGeoLocation(context.Background(), third)
+	_, _ = client.GetGeoLocation(context.Background(), first)
+	_, _ = client.GetGeoLocation(context.Background(), second)
+
+	// Assert
+	assert.Equal(t, 4, next.calls)
+	assert.Equal(t, int64(2), client.Stats().Evictions)
+}
+
+func Test_CachingClientDoesNotCacheErrors(t *testing.T) {
+	// Arrange
+	next := &fakeGeoClient{location: kernel.MaxLocation(), errs: []error{errors.New("not found")}}
+	clock := &fakeClock{current: time.Now()}
+	client, _ := NewCachingClient(next, 2, time.Minute, clock.now)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+
+	// Act
+	_, firstErr := client.GetGeoLocation(context.Background(), address)
+	location, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.Error(t, firstErr)
+	assert.NoError(t, err)
+	assert.Equal(t, kernel.MaxLocation(), location)
+	assert.Equal(t, 2, next.calls)
+}
@@ -0,0 +1,106 @@
+package geo
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitIsOpen is returned without calling the geo service while it is considered down
+var ErrCircuitIsOpen = errors.New("geo service circuit is open")
+
+var _ ports.GeoClient = &CircuitBreakerClient{}
+
+// CircuitBreakerClient stops calling the geo service after several transient failures in a row,
+// so that order intake fails fast instead of waiting for timeouts. After cooldown one call
+// is let through, its success closes the circuit again
+type CircuitBreakerClient struct {
+	next             ports.GeoClient
+	failureThreshold int
+	cooldown         time.Duration
+	now              func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewCircuitBreakerClient(
+	next ports.GeoClient, failureThreshold int, cooldown time.Duration, now func() time.Time,
+) (*CircuitBreakerClient, error) {
+	if next == nil {
+		return nil, errs.NewValueIsRequiredError("next")
+	}
+	if failureThreshold < 1 {
+		return nil, errs.NewValueIsInvalidError("failureThreshold")
+	}
+	if cooldown <= 0 {
+		return nil, errs.NewValueIsInvalidError("cooldown")
+	}
+	if now == nil {
+		return nil, errs.NewValueIsRequiredError("now")
+	}
+
+	return &CircuitBreakerClient{
+		next:             next,
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		now:              now,
+	}, nil
+}
+
+func (c *CircuitBreakerClient) GetGeoLocation(ctx context.Context, address kernel.Address) (kernel.Location, error) {
+	if !c.allow() {
+		return kernel.Location{}, ErrCircuitIsOpen
+	}
+	location, err := c.next.GetGeoLocation(ctx, address)
+	if ctx.Err() != nil {
+		// The caller gave up, it says nothing about the geo service
+		c.releaseProbe()
+		return location, err
+	}
+	c.record(err)
+	return location, err
+}
+
+func (c *CircuitBreakerClient) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.failures < c.failureThreshold {
+		return true
+	}
+	if c.probing || c.now().Sub(c.openedAt) < c.cooldown {
+		return false
+	}
+	c.probing = true
+	return true
+}
+
+// releaseProbe lets another call check the geo service when the probe was cancelled
+func (c *CircuitBreakerClient) releaseProbe() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probing = false
+}
+
+func (c *CircuitBreakerClient) record(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probing = false
+	if err == nil || !isTransient(err) {
+		c.failures = 0
+		return
+	}
+	c.failures++
+	if c.failures >= c.failureThreshold {
+		c.openedAt = c.now()
+	}
+}
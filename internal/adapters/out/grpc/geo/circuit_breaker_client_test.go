@@ -0,0 +1,112 @@
+package geo
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_CircuitBreakerClientOpensAfterTransientFailures(t *testing.T) {
+	// Arrange
+	unavailable := status.Error(codes.Unavailable, "down")
+	next := &fakeGeoClient{errs: []error{unavailable, unavailable}}
+	clock := &fakeClock{current: time.Now()}
+	client, _ := NewCircuitBreakerClient(next, 2, time.Minute, clock.now)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+	_, _ = client.GetGeoLocation(context.Background(), address)
+	_, _ = client.GetGeoLocation(context.Background(), address)
+
+	// Act
+	_, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrCircuitIsOpen)
+	assert.Equal(t, 2, next.calls)
+}
+
+func Test_CircuitBreakerClientIgnoresNotTransientFailures(t *testing.T) {
+	// Arrange
+	notFound := status.Error(codes.NotFound, "no such address")
+	next := &fakeGeoClient{errs: []error{notFound, notFound}}
+	clock := &fakeClock{current: time.Now()}
+	client, _ := NewCircuitBreakerClient(next, 2, time.Minute, clock.now)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+	_, _ = client.GetGeoLocation(context.Background(), address)
+	_, _ = client.GetGeoLocation(context.Background(), address)
+
+	// Act
+	_, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 3, next.calls)
+}
+
+func Test_CircuitBreakerClientClosesAfterSuccessfulProbe(t *testing.T) {
+	// Arrange
+	unavailable := status.Error(codes.Unavailable, "down")
+	next := &fakeGeoClient{location: kernel.MaxLocation(), errs: []error{unavailable}}
+	clock := &fakeClock{current: time.Now()}
+	client, _ := NewCircuitBreakerClient(next, 1, time.Minute, clock.now)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+	_, _ = client.GetGeoLocation(context.Background(), address)
+
+	// Act
+	_, openErr := client.GetGeoLocation(context.Background(), address)
+	clock.current = clock.current.Add(time.Minute)
+	location, probeErr := client.GetGeoLocation(context.Background(), address)
+	_, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.ErrorIs(t, openErr, ErrCircuitIsOpen)
+	assert.NoError(t, probeErr)
+	assert.Equal(t, kernel.MaxLocation(), location)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, next.calls)
+}
+
+func Test_CircuitBreakerClientDoesNotCountCancelledCalls(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	next := &fakeGeoClient{location: kernel.MaxLocation(), errs: []error{context.Canceled}}
+	clock := &fakeClock{current: time.Now()}
+	client, _ := NewCircuitBreakerClient(next, 1, time.Minute, clock.now)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+
+	// Act
+	_, cancelledErr := client.GetGeoLocation(ctx, address)
+	location, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.ErrorIs(t, cancelledErr, context.Canceled)
+	assert.NoError(t, err)
+	assert.Equal(t, kernel.MaxLocation(), location)
+	assert.Equal(t, 2, next.calls)
+}
+
+func Test_CircuitBreakerClientResetsFailuresWhenProbeIsCancelled(t *testing.T) {
+	// Arrange
+	unavailable := status.Error(codes.Unavailable, "down")
+	next := &fakeGeoClient{location: kernel.MaxLocation(), errs: []error{unavailable, context.Canceled}}
+	clock := &fakeClock{current: time.Now()}
+	client, _ := NewCircuitBreakerClient(next, 1, time.Minute, clock.now)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+	_, _ = client.GetGeoLocation(context.Background(), address)
+	clock.current = clock.current.Add(time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	_, _ = client.GetGeoLocation(ctx, address)
+	_, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 3, next.calls)
+}
@@ -0,0 +1,34 @@
+package geo
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"time"
+)
+
+// fakeGeoClient answers with the queued errors first and with location afterwards
+type fakeGeoClient struct {
+	location kernel.Location
+	errs     []error
+	calls    int
+}
+
+func (c *fakeGeoClient) GetGeoLocation(ctx context.Context, address kernel.Address) (kernel.Location, error) {
+	c.calls++
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+		if err != nil {
+			return kernel.Location{}, err
+		}
+	}
+	return c.location, nil
+}
+
+type fakeClock struct {
+	current time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.current
+}
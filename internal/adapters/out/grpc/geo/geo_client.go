@@ -7,46 +7,57 @@ import (
 	"delivery/internal/core/ports"
 	"delivery/internal/generated/clients/geosrv/geopb"
 	"delivery/internal/pkg/errs"
-	"log"
+	"fmt"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// DefaultTimeout limits a single call to the geo service
+const DefaultTimeout = 5 * time.Second
+
 var _ ports.GeoClient = &Client{}
 
 type Client struct {
-	conn *grpc.ClientConn
+	conn     *grpc.ClientConn
 	pbClient geopb.GeoClient
-	timeout time.Duration
+	timeout  time.Duration
 }
 
-func NewClient(host string) (*Client, error) {
+func NewClient(host string, timeout time.Duration) (*Client, error) {
 	if host == "" {
 		return nil, errs.NewValueIsInvalidError("host")
 	}
+	if timeout <= 0 {
+		return nil, errs.NewValueIsInvalidError("timeout")
+	}
 
 	conn, err := grpc.NewClient(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("failed to create geo service connection: %w", err)
 	}
 
 	pbClient := geopb.NewGeoClient(conn)
 
 	return &Client{
-		conn: conn,
+		conn:     conn,
 		pbClient: pbClient,
-		timeout: 5 * time.Second,
+		timeout:  timeout,
 	}, nil
 }
 
-func (c *Client) GetGeoLocation(_ context.Context, street string) (kernel.Location, error) {
+// GetGeoLocation sends the structured address, so that equal street names
+// in different cities are told apart by the geo service
+func (c *Client) GetGeoLocation(ctx context.Context, address kernel.Address) (kernel.Location, error) {
 	request := &geopb.GetGeolocationRequest{
-		Street: street,
+		Street:  address.Street(),
+		Country: address.Country(),
+		City:    address.City(),
+		House:   address.House(),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	resp, err := c.pbClient.GetGeolocation(ctx, request)
@@ -54,7 +65,12 @@ func (c *Client) GetGeoLocation(_ context.Context, street string) (kernel.Locati
 		return kernel.Location{}, err
 	}
 
-	return kernel.NewLocation(uint8(resp.Location.X), uint8(resp.Location.Y))
+	// Geo service may know a wider map than the configured grid
+	location, err := kernel.NewLocation(int(resp.GetLocation().GetX()), int(resp.GetLocation().GetY()))
+	if err != nil {
+		return kernel.Location{}, fmt.Errorf("geo service returned location out of the grid: %w", err)
+	}
+	return location, nil
 }
 
 func (c *Client) Close() error {
@@ -0,0 +1,65 @@
+package geo
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ ports.GeoClient = &RetryingClient{}
+
+// RetryingClient repeats calls failed for a transient reason, waiting twice longer every time
+type RetryingClient struct {
+	next        ports.GeoClient
+	maxAttempts int
+	backoff     time.Duration
+}
+
+func NewRetryingClient(next ports.GeoClient, maxAttempts int, backoff time.Duration) (*RetryingClient, error) {
+	if next == nil {
+		return nil, errs.NewValueIsRequiredError("next")
+	}
+	if maxAttempts < 1 {
+		return nil, errs.NewValueIsInvalidError("maxAttempts")
+	}
+	if backoff < 0 {
+		return nil, errs.NewValueIsInvalidError("backoff")
+	}
+
+	return &RetryingClient{
+		next:        next,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}, nil
+}
+
+func (c *RetryingClient) GetGeoLocation(ctx context.Context, address kernel.Address) (kernel.Location, error) {
+	delay := c.backoff
+	for attempt := 1; ; attempt++ {
+		location, err := c.next.GetGeoLocation(ctx, address)
+		if err == nil || !isTransient(err) || attempt >= c.maxAttempts {
+			return location, err
+		}
+		select {
+		case <-ctx.Done():
+			return kernel.Location{}, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// isTransient tells errors which may go away on their own, such as the geo service restarting.
+// Unknown address will not be found on the next attempt either
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package geo
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_RetryingClientRepeatsTransientFailures(t *testing.T) {
+	// Arrange
+	unavailable := status.Error(codes.Unavailable, "down")
+	next := &fakeGeoClient{location: kernel.MaxLocation(), errs: []error{unavailable, unavailable}}
+	client, _ := NewRetryingClient(next, 3, 0)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+
+	// Act
+	location, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, kernel.MaxLocation(), location)
+	assert.Equal(t, 3, next.calls)
+}
+
+func Test_RetryingClientGivesUpAfterMaxAttempts(t *testing.T) {
+	// Arrange
+	unavailable := status.Error(codes.Unavailable, "down")
+	next := &fakeGeoClient{errs: []error{unavailable, unavailable, unavailable}}
+	client, _ := NewRetryingClient(next, 2, 0)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+
+	// Act
+	_, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 2, next.calls)
+}
+
+func Test_RetryingClientDoesNotRepeatNotTransientFailures(t *testing.T) {
+	// Arrange
+	notFound := status.Error(codes.NotFound, "no such address")
+	next := &fakeGeoClient{errs: []error{notFound}}
+	client, _ := NewRetryingClient(next, 3, 0)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+
+	// Act
+	_, err := client.GetGeoLocation(context.Background(), address)
+
+	// Assert
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, 1, next.calls)
+}
+
+func Test_RetryingClientStopsWhenContextIsCancelled(t *testing.T) {
+	// Arrange
+	unavailable := status.Error(codes.Unavailable, "down")
+	next := &fakeGeoClient{errs: []error{unavailable, unavailable}}
+	client, _ := NewRetryingClient(next, 3, time.Hour)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	_, err := client.GetGeoLocation(ctx, address)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, next.calls)
+}
@@ -0,0 +1,46 @@
+// Package handover
+package handover
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"math/rand/v2"
+
+	"github.com/google/uuid"
+)
+
+var _ ports.HandoverClient = &SimulatedClient{}
+
+var failureReasons = []order.FailureReason{
+	order.FailureReasonRecipientAbsent,
+	order.FailureReasonAddressNotFound,
+	order.FailureReasonRefused,
+	order.FailureReasonDamaged,
+}
+
+// SimulatedClient fails the given share of handovers with a random reason,
+// until there is a real courier app to report the outcome
+type SimulatedClient struct {
+	failureRate float64
+}
+
+func NewSimulatedClient(failureRate float64) (*SimulatedClient, error) {
+	if failureRate < 0 || failureRate > 1 {
+		return nil, errs.NewValueIsOutOfRangeError("failureRate", failureRate, 0, 1)
+	}
+	return &SimulatedClient{
+		failureRate: failureRate,
+	}, nil
+}
+
+func (c *SimulatedClient) HandOver(ctx context.Context, _ uuid.UUID) (order.FailureReason, error) {
+	if ctx.Err() != nil {
+		return order.FailureReasonNone, ctx.Err()
+	}
+	if rand.Float64() >= c.failureRate {
+		return order.FailureReasonNone, nil
+	}
+	return failureReasons[rand.IntN(len(failureReasons))], nil
+}
@@ -0,0 +1,107 @@
+// Package kafka
+package kafka
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/generated/queues/orderstatuschangedpb"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+)
+
+var _ ports.OrderProducer = &orderProducer{}
+
+type orderProducer struct {
+	topic    string
+	producer sarama.SyncProducer
+}
+
+func NewOrderProducer(brokers []string, topic string) (ports.OrderProducer, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+	if topic == "" {
+		return nil, errs.NewValueIsRequiredError("topic")
+	}
+
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.Version = sarama.V3_4_0_0
+	saramaCfg.Producer.RequiredAcks = sarama.WaitForAll
+	saramaCfg.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(brokers, saramaCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sync producer: %w", err)
+	}
+
+	return &orderProducer{
+		topic:    topic,
+		producer: producer,
+	}, nil
+}
+
+func (p *orderProducer) Close() error {
+	return p.producer.Close()
+}
+
+// Publish sends order status changes to Kafka, other domain events are not
+// part of the integration contract and are skipped
+func (p *orderProducer) Publish(ctx context.Context, domainEvent ddd.DomainEvent) error {
+	if domainEvent == nil {
+		return errs.NewValueIsRequiredError("domainEvent")
+	}
+
+	var orderID uuid.UUID
+	var orderStatus string
+	switch event := domainEvent.(type) {
+	case *order.OrderCreatedDomainEvent:
+		orderID, orderStatus = event.OrderID, event.OrderStatus
+	case *order.OrderAssignedDomainEvent:
+		orderID, orderStatus = event.OrderID, event.OrderStatus
+	case *order.OrderPickedUpDomainEvent:
+		orderID, orderStatus = event.OrderID, event.OrderStatus
+	case *order.OrderCompletedDomainEvent:
+		orderID, orderStatus = event.OrderID, event.OrderStatus
+	case *order.OrderCancelledDomainEvent:
+		orderID, orderStatus = event.OrderID, event.OrderStatus
+	case *order.OrderDeliveryFailedDomainEvent:
+		orderID, orderStatus = event.OrderID, event.OrderStatus
+	case *order.OrderReturnedToSenderDomainEvent:
+		orderID, orderStatus = event.OrderID, event.OrderStatus
+	default:
+		return nil
+	}
+
+	integrationEvent := orderstatuschangedpb.OrderStatusChangedIntegrationEvent{
+		OrderId:     orderID.String(),
+		OrderStatus: orderstatuschangedpb.OrderStatus(orderstatuschangedpb.OrderStatus_value[orderStatus]),
+	}
+	payload, err := json.Marshal(&integrationEvent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal integration event: %w", err)
+	}
+
+	message := &sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder(orderID.String()),
+		Value: sarama.ByteEncoder(payload),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("event_id"), Value: []byte(domainEvent.GetID().String())},
+		},
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	_, _, err = p.producer.SendMessage(message)
+	if err != nil {
+		return fmt.Errorf("failed to send message to Kafka: %w", err)
+	}
+	return nil
+}
@@ -1,26 +1,51 @@
 package courierrepo
 
-import "github.com/google/uuid"
+import (
+	"delivery/internal/core/domain/model/courier"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type CourierDTO struct {
 	ID            uuid.UUID `gorm:"type:uuid;primaryKey"`
 	Name          string
 	Location      LocationDTO `gorm:"embedded;embeddedPrefix:location_"`
 	Speed         int
+	Transport     courier.Transport `gorm:"type:varchar(20)"`
+	MaxPayload    int
+	Status        courier.Status     `gorm:"type:varchar(20)"`
+	Version       int64              `gorm:"not null;default:0"`
 	StoragePlaces []*StoragePlaceDTO `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE;"`
+	Stops         []*StopDTO         `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE;"`
 }
 
 type StoragePlaceDTO struct {
-	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
-	Name        string
-	TotalVolume int
-	OrderID     *uuid.UUID `gorm:"type:uuid"`
-	CourierID   *uuid.UUID `gorm:"type:uuid;index"`
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name         string
+	TotalVolume  int
+	Refrigerated bool
+	FragileSafe  bool
+	MaxWeight    int
+	OrderID      *uuid.UUID `gorm:"type:uuid"`
+	OrderWeight  int
+	CourierID    *uuid.UUID `gorm:"type:uuid;index"`
+}
+
+type StopDTO struct {
+	OrderID   uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Kind      string    `gorm:"type:varchar(20);primaryKey"`
+	CourierID uuid.UUID `gorm:"type:uuid;index"`
+	Sequence  int
+	Location  LocationDTO `gorm:"embedded;embeddedPrefix:location_"`
+	NotBefore *time.Time
 }
 
 type LocationDTO struct {
-	X int
-	Y int
+	X         int
+	Y         int
+	Latitude  *float64
+	Longitude *float64
 }
 
 func (CourierDTO) TableName() string {
@@ -30,3 +55,7 @@ func (CourierDTO) TableName() string {
 func (StoragePlaceDTO) TableName() string {
 	return "storage_places"
 }
+
+func (StopDTO) TableName() string {
+	return "courier_stops"
+}
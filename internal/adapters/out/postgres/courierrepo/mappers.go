@@ -4,6 +4,8 @@ package courierrepo
 import (
 	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/courier"
+	"sort"
+	"time"
 )
 
 func DomainToDTO(aggregate *courier.Courier) CourierDTO {
@@ -11,10 +13,11 @@ func DomainToDTO(aggregate *courier.Courier) CourierDTO {
 	courierDTO.ID = aggregate.ID()
 	courierDTO.Name = aggregate.Name()
 	courierDTO.Speed = aggregate.Speed()
-	courierDTO.Location = LocationDTO{
-		X: int(aggregate.Location().X()),
-		Y: int(aggregate.Location().Y()),
-	}
+	courierDTO.Transport = aggregate.Transport()
+	courierDTO.MaxPayload = aggregate.MaxPayload().Grams()
+	courierDTO.Status = aggregate.Status()
+	courierDTO.Version = aggregate.Version()
+	courierDTO.Location = LocationDomainToDTO(aggregate.Location())
 	storagePlaces := make([]*StoragePlaceDTO, 0, len(aggregate.StoragePlaces()))
 	for _, sp := range aggregate.StoragePlaces() {
 		spToDTO := SPDomainToDTO(sp)
@@ -23,6 +26,18 @@ func DomainToDTO(aggregate *courier.Courier) CourierDTO {
 		storagePlaces = append(storagePlaces, &spToDTO)
 	}
 	courierDTO.StoragePlaces = storagePlaces
+	stops := make([]*StopDTO, 0, len(aggregate.Stops()))
+	for i, stop := range aggregate.Stops() {
+		stops = append(stops, &StopDTO{
+			OrderID:   stop.OrderID(),
+			Kind:      stop.Kind().String(),
+			CourierID: courierDTO.ID,
+			Sequence:  i,
+			Location:  LocationDomainToDTO(stop.Location()),
+			NotBefore: timeDomainToDTO(stop.NotBefore()),
+		})
+	}
+	courierDTO.Stops = stops
 	return courierDTO
 }
 
@@ -31,23 +46,72 @@ func SPDomainToDTO(entity *courier.StoragePlace) StoragePlaceDTO {
 	sPDTO.ID = entity.ID()
 	sPDTO.Name = entity.Name()
 	sPDTO.TotalVolume = int(entity.TotalVolume())
+	sPDTO.Refrigerated = entity.Equipment().Refrigerated()
+	sPDTO.FragileSafe = entity.Equipment().FragileSafe()
+	sPDTO.MaxWeight = entity.Equipment().MaxWeight().Grams()
 	sPDTO.OrderID = entity.OrderID()
+	sPDTO.OrderWeight = entity.OrderWeight().Grams()
 	return sPDTO
 }
 
 func DtoToDomain(dto CourierDTO) *courier.Courier {
 	var aggregate *courier.Courier
-	location, _ := kernel.NewLocation(uint8(dto.Location.X), uint8(dto.Location.Y))
+	location := LocationDtoToDomain(dto.Location)
 	storagePlaces := make([]*courier.StoragePlace, 0, len(dto.StoragePlaces))
 	for _, sp := range dto.StoragePlaces {
-		spToDomain := courier.RestoreStoragePlace(sp.Name, kernel.Volume(sp.TotalVolume), sp.ID, sp.OrderID)
-		storagePlaces = append(storagePlaces, spToDomain)
+		storagePlaces = append(storagePlaces, SPDtoToDomain(*sp))
 	}
-	aggregate = courier.RestoreCourier(dto.Name, dto.Speed, location, dto.ID, storagePlaces)
+	sort.SliceStable(dto.Stops, func(i, j int) bool {
+		return dto.Stops[i].Sequence < dto.Stops[j].Sequence
+	})
+	stops := make([]courier.Stop, 0, len(dto.Stops))
+	for _, stop := range dto.Stops {
+		stopLocation := LocationDtoToDomain(stop.Location)
+		var notBefore time.Time
+		if stop.NotBefore != nil {
+			notBefore = *stop.NotBefore
+		}
+		stops = append(stops, courier.RestoreStop(stop.OrderID, courier.StopKind(stop.Kind), stopLocation, notBefore))
+	}
+	aggregate = courier.RestoreCourier(
+		dto.Name, dto.Speed, dto.Transport, location, dto.ID, dto.Status, kernel.Weight(dto.MaxPayload), storagePlaces,
+		stops, dto.Version,
+	)
 	return aggregate
 }
 
 func SPDtoToDomain(dto StoragePlaceDTO) *courier.StoragePlace {
-	entity := courier.RestoreStoragePlace(dto.Name, kernel.Volume(dto.TotalVolume), dto.ID, dto.OrderID)
+	equipment := courier.NewEquipment(dto.Refrigerated, dto.FragileSafe, kernel.Weight(dto.MaxWeight))
+	entity := courier.RestoreStoragePlace(
+		dto.Name, kernel.Volume(dto.TotalVolume), equipment, dto.ID, dto.OrderID, kernel.Weight(dto.OrderWeight),
+	)
 	return entity
 }
+
+func LocationDomainToDTO(location kernel.Location) LocationDTO {
+	if location.IsGeo() {
+		latitude, longitude := location.Latitude(), location.Longitude()
+		return LocationDTO{Latitude: &latitude, Longitude: &longitude}
+	}
+	return LocationDTO{
+		X: location.X(),
+		Y: location.Y(),
+	}
+}
+
+func LocationDtoToDomain(dto LocationDTO) kernel.Location {
+	if dto.Latitude != nil && dto.Longitude != nil {
+		location, _ := kernel.NewGeoLocation(*dto.Latitude, *dto.Longitude)
+		return location
+	}
+	location, _ := kernel.NewLocation(dto.X, dto.Y)
+	return location
+}
+
+// timeDomainToDTO stores zero time as NULL
+func timeDomainToDTO(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -28,8 +28,8 @@ func NewRepository(tracker Tracker) (*Repository, error) {
 }
 
 func (r *Repository) Add(ctx context.Context, aggregate *courier.Courier) error {
-	// r.tracker.Track(aggregate)
-	//
+	r.tracker.Track(aggregate)
+
 	dto := DomainToDTO(aggregate)
 
 	isInTransaction := r.tracker.InTx()
@@ -57,7 +57,7 @@ func (r *Repository) Add(ctx context.Context, aggregate *courier.Courier) error
 }
 
 func (r *Repository) Update(ctx context.Context, aggregate *courier.Courier) error {
-	// r.tracker.Track(aggregate)
+	r.tracker.Track(aggregate)
 
 	dto := DomainToDTO(aggregate)
 
@@ -70,16 +70,55 @@ func (r *Repository) Update(ctx context.Context, aggregate *courier.Courier) err
 		return errs.NewValueIsRequiredError("transaction not initialized")
 	}
 
-	session := &gorm.Session{FullSaveAssociations: true}
+	// Optimistic lock: the row is updated only if nobody changed it since it was loaded
+	expectedVersion := aggregate.Version()
+	dto.Version = expectedVersion + 1
+	result := tx.WithContext(ctx).
+		Model(&CourierDTO{ID: dto.ID}).
+		Where("version = ?", expectedVersion).
+		Select("*").
+		Omit(clause.Associations).
+		Updates(&dto)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.NewVersionIsInvalidError("courier")
+	}
+
+	// Removed storage places are the ones which are not in the aggregate any more, a courier has at least one
+	storagePlaceIDs := make([]uuid.UUID, 0, len(dto.StoragePlaces))
+	for _, place := range dto.StoragePlaces {
+		storagePlaceIDs = append(storagePlaceIDs, place.ID)
+	}
 	err := tx.WithContext(ctx).
-		Session(session).
-		Save(&dto).Error
+		Where("courier_id = ? AND id NOT IN ?", dto.ID, storagePlaceIDs).
+		Delete(&StoragePlaceDTO{}).Error
 	if err != nil {
 		return err
 	}
+	if len(dto.StoragePlaces) > 0 {
+		err := tx.WithContext(ctx).Save(dto.StoragePlaces).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	// The route is small and replanned as a whole, so it is simply rewritten
+	err = tx.WithContext(ctx).Where("courier_id = ?", dto.ID).Delete(&StopDTO{}).Error
+	if err != nil {
+		return err
+	}
+	if len(dto.Stops) > 0 {
+		err := tx.WithContext(ctx).Create(dto.Stops).Error
+		if err != nil {
+			return err
+		}
+	}
+	aggregate.SetVersion(dto.Version)
 
 	if !isInTransaction {
-		err := r.tracker.Commit(ctx)
+		err = r.tracker.Commit(ctx)
 		if err != nil {
 			return err
 		}
@@ -107,11 +146,13 @@ func (r *Repository) GetAllAvailable(ctx context.Context) ([]*courier.Courier, e
 	var couriers []CourierDTO
 	tx := r.getTxOrDB()
 	result := tx.WithContext(ctx).
-		Preload("StoragePlaces").
-		Where("NOT EXISTS (?)",
+		Preload(clause.Associations).
+		// A courier is available on shift while at least one storage place is free
+		Where("status = ?", courier.StatusOnShift).
+		Where("EXISTS (?)",
 			tx.Model(&StoragePlaceDTO{}).
 				Select("1").
-				Where("storage_places.courier_id = couriers.id AND storage_places.order_id IS NOT NULL"),
+				Where("storage_places.courier_id = couriers.id AND storage_places.order_id IS NULL"),
 		).
 		Find(&couriers)
 
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS outbox;
+DROP TABLE IF EXISTS orders;
+DROP TABLE IF EXISTS storage_places;
+DROP TABLE IF EXISTS couriers;
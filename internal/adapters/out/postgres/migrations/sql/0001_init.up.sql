@@ -0,0 +1,44 @@
+-- Baseline schema, matches what GORM AutoMigrate used to create,
+-- so existing databases can be adopted without changes
+CREATE TABLE IF NOT EXISTS couriers (
+    id         uuid PRIMARY KEY,
+    name       text,
+    location_x bigint,
+    location_y bigint,
+    speed      bigint,
+    version    bigint NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS storage_places (
+    id           uuid PRIMARY KEY,
+    name         text,
+    total_volume bigint,
+    order_id     uuid,
+    courier_id   uuid,
+    CONSTRAINT fk_couriers_storage_places FOREIGN KEY (courier_id) REFERENCES couriers (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_storage_places_courier_id ON storage_places (courier_id);
+
+CREATE TABLE IF NOT EXISTS orders (
+    id         uuid PRIMARY KEY,
+    courier_id uuid,
+    location_x bigint,
+    location_y bigint,
+    volume     bigint,
+    status     varchar(20),
+    version    bigint NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_orders_courier_id ON orders (courier_id);
+
+-- Tables created by AutoMigrate before optimistic locking have no version column
+ALTER TABLE couriers ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS outbox (
+    id               uuid PRIMARY KEY,
+    name             text,
+    payload          bytea,
+    occurred_at_utc  timestamptz,
+    processed_at_utc timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_outbox_processed_at_utc ON outbox (processed_at_utc);
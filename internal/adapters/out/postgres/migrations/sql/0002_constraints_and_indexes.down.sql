@@ -0,0 +1,24 @@
+DROP INDEX IF EXISTS idx_outbox_not_processed;
+CREATE INDEX IF NOT EXISTS idx_outbox_processed_at_utc ON outbox (processed_at_utc);
+
+DROP INDEX IF EXISTS idx_storage_places_order_id;
+DROP INDEX IF EXISTS idx_orders_status;
+
+ALTER TABLE orders
+    DROP CONSTRAINT IF EXISTS chk_orders_volume,
+    DROP CONSTRAINT IF EXISTS chk_orders_status,
+    ALTER COLUMN status DROP NOT NULL,
+    ALTER COLUMN volume DROP NOT NULL,
+    ALTER COLUMN location_y DROP NOT NULL,
+    ALTER COLUMN location_x DROP NOT NULL;
+
+ALTER TABLE storage_places
+    DROP CONSTRAINT IF EXISTS chk_storage_places_total_volume,
+    ALTER COLUMN total_volume DROP NOT NULL,
+    ALTER COLUMN name DROP NOT NULL;
+
+ALTER TABLE couriers
+    ALTER COLUMN speed DROP NOT NULL,
+    ALTER COLUMN location_y DROP NOT NULL,
+    ALTER COLUMN location_x DROP NOT NULL,
+    ALTER COLUMN name DROP NOT NULL;
@@ -0,0 +1,28 @@
+ALTER TABLE couriers
+    ALTER COLUMN name SET NOT NULL,
+    ALTER COLUMN location_x SET NOT NULL,
+    ALTER COLUMN location_y SET NOT NULL,
+    ALTER COLUMN speed SET NOT NULL;
+
+ALTER TABLE storage_places
+    ALTER COLUMN name SET NOT NULL,
+    ALTER COLUMN total_volume SET NOT NULL,
+    ADD CONSTRAINT chk_storage_places_total_volume CHECK (total_volume > 0);
+
+ALTER TABLE orders
+    ALTER COLUMN location_x SET NOT NULL,
+    ALTER COLUMN location_y SET NOT NULL,
+    ALTER COLUMN volume SET NOT NULL,
+    ALTER COLUMN status SET NOT NULL,
+    ADD CONSTRAINT chk_orders_status CHECK (status IN ('Created', 'Assigned', 'Completed')),
+    ADD CONSTRAINT chk_orders_volume CHECK (volume > 0);
+
+-- AssignOrdersJob and MoveCouriersJob look orders up by status
+CREATE INDEX idx_orders_status ON orders (status);
+
+-- GetAllAvailable looks for occupied storage places
+CREATE INDEX idx_storage_places_order_id ON storage_places (order_id) WHERE order_id IS NOT NULL;
+
+-- The outbox relay only reads messages which are not published yet
+DROP INDEX IF EXISTS idx_outbox_processed_at_utc;
+CREATE INDEX idx_outbox_not_processed ON outbox (occurred_at_utc) WHERE processed_at_utc IS NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_storage_places_free;
+CREATE INDEX idx_storage_places_order_id ON storage_places (order_id) WHERE order_id IS NOT NULL;
+
+DROP TABLE IF EXISTS courier_stops;
@@ -0,0 +1,24 @@
+-- Ordered route of a courier, one stop per carried order
+CREATE TABLE courier_stops (
+    order_id   uuid PRIMARY KEY,
+    courier_id uuid   NOT NULL,
+    sequence   bigint NOT NULL,
+    location_x bigint NOT NULL,
+    location_y bigint NOT NULL,
+    CONSTRAINT fk_couriers_stops FOREIGN KEY (courier_id) REFERENCES couriers (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_courier_stops_courier_id ON courier_stops (courier_id);
+
+-- Orders which are already on the way become the first stops of their couriers
+INSERT INTO courier_stops (order_id, courier_id, sequence, location_x, location_y)
+SELECT id,
+       courier_id,
+       row_number() OVER (PARTITION BY courier_id ORDER BY id) - 1,
+       location_x,
+       location_y
+FROM orders
+WHERE status = 'Assigned' AND courier_id IS NOT NULL;
+
+-- GetAllAvailable now looks for free storage places
+DROP INDEX IF EXISTS idx_storage_places_order_id;
+CREATE INDEX idx_storage_places_free ON storage_places (courier_id) WHERE order_id IS NULL;
@@ -0,0 +1,13 @@
+DELETE FROM courier_stops WHERE kind = 'Pickup';
+ALTER TABLE courier_stops
+    DROP CONSTRAINT chk_courier_stops_kind,
+    DROP CONSTRAINT courier_stops_pkey,
+    ADD PRIMARY KEY (order_id);
+ALTER TABLE courier_stops DROP COLUMN kind;
+
+UPDATE orders SET status = 'Assigned' WHERE status = 'PickedUp';
+ALTER TABLE orders
+    DROP CONSTRAINT chk_orders_status,
+    ADD CONSTRAINT chk_orders_status CHECK (status IN ('Created', 'Assigned', 'Completed')),
+    DROP COLUMN pickup_x,
+    DROP COLUMN pickup_y;
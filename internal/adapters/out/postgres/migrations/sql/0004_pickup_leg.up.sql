@@ -0,0 +1,22 @@
+-- Orders are collected at a pickup location before they are delivered
+ALTER TABLE orders
+    ADD COLUMN pickup_x bigint,
+    ADD COLUMN pickup_y bigint;
+
+-- Existing orders were treated as already collected
+UPDATE orders SET pickup_x = location_x, pickup_y = location_y;
+UPDATE orders SET status = 'PickedUp' WHERE status = 'Assigned';
+
+ALTER TABLE orders
+    ALTER COLUMN pickup_x SET NOT NULL,
+    ALTER COLUMN pickup_y SET NOT NULL,
+    DROP CONSTRAINT chk_orders_status,
+    ADD CONSTRAINT chk_orders_status CHECK (status IN ('Created', 'Assigned', 'PickedUp', 'Completed'));
+
+-- A courier route has a pickup and a drop-off stop for every order
+ALTER TABLE courier_stops ADD COLUMN kind varchar(20) NOT NULL DEFAULT 'DropOff';
+ALTER TABLE courier_stops ALTER COLUMN kind DROP DEFAULT;
+ALTER TABLE courier_stops
+    DROP CONSTRAINT courier_stops_pkey,
+    ADD PRIMARY KEY (order_id, kind),
+    ADD CONSTRAINT chk_courier_stops_kind CHECK (kind IN ('Pickup', 'DropOff'));
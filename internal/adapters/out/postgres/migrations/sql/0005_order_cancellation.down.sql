@@ -0,0 +1,5 @@
+-- Cancelled orders can not be represented anymore, so they are removed
+DELETE FROM orders WHERE status = 'Cancelled';
+ALTER TABLE orders
+    DROP CONSTRAINT chk_orders_status,
+    ADD CONSTRAINT chk_orders_status CHECK (status IN ('Created', 'Assigned', 'PickedUp', 'Completed'));
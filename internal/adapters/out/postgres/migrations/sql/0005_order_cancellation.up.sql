@@ -0,0 +1,3 @@
+ALTER TABLE orders
+    DROP CONSTRAINT chk_orders_status,
+    ADD CONSTRAINT chk_orders_status CHECK (status IN ('Created', 'Assigned', 'PickedUp', 'Completed', 'Cancelled'));
@@ -0,0 +1,13 @@
+DELETE FROM courier_stops WHERE kind = 'Return';
+ALTER TABLE courier_stops
+    DROP CONSTRAINT chk_courier_stops_kind,
+    ADD CONSTRAINT chk_courier_stops_kind CHECK (kind IN ('Pickup', 'DropOff'));
+
+DROP TABLE IF EXISTS order_delivery_attempts;
+
+-- Failed orders go back to the courier bag, returned ones can not be represented anymore
+UPDATE orders SET status = 'PickedUp' WHERE status = 'DeliveryFailed';
+DELETE FROM orders WHERE status = 'ReturnedToSender';
+ALTER TABLE orders
+    DROP CONSTRAINT chk_orders_status,
+    ADD CONSTRAINT chk_orders_status CHECK (status IN ('Created', 'Assigned', 'PickedUp', 'Completed', 'Cancelled'));
@@ -0,0 +1,20 @@
+ALTER TABLE orders
+    DROP CONSTRAINT chk_orders_status,
+    ADD CONSTRAINT chk_orders_status CHECK (status IN (
+        'Created', 'Assigned', 'PickedUp', 'Completed', 'Cancelled', 'DeliveryFailed', 'ReturnedToSender'
+    ));
+
+-- Every try to hand the parcel over, an empty reason means success
+CREATE TABLE order_delivery_attempts (
+    order_id     uuid        NOT NULL,
+    number       bigint      NOT NULL,
+    reason       varchar(30) NOT NULL DEFAULT '',
+    attempted_at timestamptz NOT NULL,
+    PRIMARY KEY (order_id, number),
+    CONSTRAINT fk_orders_delivery_attempts FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+);
+
+-- Undelivered parcels are brought back to pickup location
+ALTER TABLE courier_stops
+    DROP CONSTRAINT chk_courier_stops_kind,
+    ADD CONSTRAINT chk_courier_stops_kind CHECK (kind IN ('Pickup', 'DropOff', 'Return'));
@@ -0,0 +1,5 @@
+ALTER TABLE orders
+    DROP CONSTRAINT chk_orders_delivery_period,
+    DROP COLUMN delivery_from,
+    DROP COLUMN delivery_to,
+    DROP COLUMN delivery_period_missed;
@@ -0,0 +1,8 @@
+-- Time window the customer has chosen at checkout, empty when any time suits
+ALTER TABLE orders
+    ADD COLUMN delivery_from          timestamptz,
+    ADD COLUMN delivery_to            timestamptz,
+    ADD COLUMN delivery_period_missed boolean NOT NULL DEFAULT false,
+    ADD CONSTRAINT chk_orders_delivery_period CHECK (
+        (delivery_from IS NULL AND delivery_to IS NULL) OR delivery_from < delivery_to
+    );
@@ -0,0 +1,6 @@
+ALTER TABLE orders
+    DROP COLUMN address_country,
+    DROP COLUMN address_city,
+    DROP COLUMN address_street,
+    DROP COLUMN address_house,
+    DROP COLUMN address_apartment;
@@ -0,0 +1,7 @@
+-- Full delivery address. Nothing is backfilled, orders created before get empty address fields
+ALTER TABLE orders
+    ADD COLUMN address_country   varchar(100) NOT NULL DEFAULT '',
+    ADD COLUMN address_city      varchar(100) NOT NULL DEFAULT '',
+    ADD COLUMN address_street    varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN address_house     varchar(20)  NOT NULL DEFAULT '',
+    ADD COLUMN address_apartment varchar(20)  NOT NULL DEFAULT '';
@@ -0,0 +1,13 @@
+-- Order manifest, price is per unit in minor currency units
+CREATE TABLE order_items (
+    order_id uuid         NOT NULL,
+    number   bigint       NOT NULL,
+    good_id  uuid         NOT NULL,
+    title    varchar(255) NOT NULL,
+    price    bigint       NOT NULL,
+    quantity bigint       NOT NULL,
+    PRIMARY KEY (order_id, number),
+    CONSTRAINT fk_orders_items FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE,
+    CONSTRAINT chk_order_items_price CHECK (price >= 0),
+    CONSTRAINT chk_order_items_quantity CHECK (quantity > 0)
+);
@@ -0,0 +1,9 @@
+-- Handled integration events, so that a redelivered message is not applied twice
+CREATE TABLE inbox (
+    topic            text        NOT NULL,
+    event_id         text        NOT NULL,
+    partition        integer     NOT NULL,
+    "offset"         bigint      NOT NULL,
+    processed_at_utc timestamptz NOT NULL,
+    PRIMARY KEY (topic, event_id)
+);
@@ -0,0 +1,13 @@
+ALTER TABLE orders
+    DROP COLUMN pickup_latitude,
+    DROP COLUMN pickup_longitude,
+    DROP COLUMN location_latitude,
+    DROP COLUMN location_longitude;
+
+ALTER TABLE courier_stops
+    DROP COLUMN location_latitude,
+    DROP COLUMN location_longitude;
+
+ALTER TABLE couriers
+    DROP COLUMN location_latitude,
+    DROP COLUMN location_longitude;
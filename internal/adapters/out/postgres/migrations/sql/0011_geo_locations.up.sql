@@ -0,0 +1,14 @@
+-- Locations may be lat/lon points instead of grid cells, grid locations keep them empty
+ALTER TABLE couriers
+    ADD COLUMN location_latitude  double precision,
+    ADD COLUMN location_longitude double precision;
+
+ALTER TABLE courier_stops
+    ADD COLUMN location_latitude  double precision,
+    ADD COLUMN location_longitude double precision;
+
+ALTER TABLE orders
+    ADD COLUMN pickup_latitude    double precision,
+    ADD COLUMN pickup_longitude   double precision,
+    ADD COLUMN location_latitude  double precision,
+    ADD COLUMN location_longitude double precision;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_couriers_status;
+ALTER TABLE couriers
+    DROP CONSTRAINT chk_couriers_status,
+    DROP COLUMN status;
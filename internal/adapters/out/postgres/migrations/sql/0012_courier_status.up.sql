@@ -0,0 +1,8 @@
+-- Couriers work in shifts, the existing ones are treated as being on shift
+ALTER TABLE couriers ADD COLUMN status varchar(20) NOT NULL DEFAULT 'OnShift';
+ALTER TABLE couriers ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE couriers
+    ADD CONSTRAINT chk_couriers_status CHECK (status IN ('OffDuty', 'OnShift', 'OnBreak', 'Deactivated'));
+
+-- GetAllAvailable looks for couriers on shift
+CREATE INDEX idx_couriers_status ON couriers (status);
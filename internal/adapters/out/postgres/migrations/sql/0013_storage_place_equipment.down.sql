@@ -0,0 +1,8 @@
+ALTER TABLE order_items
+    DROP COLUMN fragile,
+    DROP COLUMN frozen;
+ALTER TABLE storage_places
+    DROP CONSTRAINT chk_storage_places_max_weight,
+    DROP COLUMN max_weight,
+    DROP COLUMN fragile_safe,
+    DROP COLUMN refrigerated;
@@ -0,0 +1,12 @@
+-- Storage places are equipped for particular goods, a zero max weight means no limit
+ALTER TABLE storage_places
+    ADD COLUMN refrigerated boolean NOT NULL DEFAULT false,
+    ADD COLUMN fragile_safe boolean NOT NULL DEFAULT false,
+    ADD COLUMN max_weight integer NOT NULL DEFAULT 0;
+ALTER TABLE storage_places
+    ADD CONSTRAINT chk_storage_places_max_weight CHECK (max_weight >= 0);
+
+-- Items tell which equipment an order needs
+ALTER TABLE order_items
+    ADD COLUMN frozen boolean NOT NULL DEFAULT false,
+    ADD COLUMN fragile boolean NOT NULL DEFAULT false;
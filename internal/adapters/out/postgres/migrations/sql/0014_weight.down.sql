@@ -0,0 +1,10 @@
+ALTER TABLE storage_places DROP COLUMN order_weight;
+ALTER TABLE couriers
+    DROP CONSTRAINT chk_couriers_max_payload,
+    DROP COLUMN max_payload;
+ALTER TABLE order_items
+    DROP CONSTRAINT chk_order_items_weight,
+    DROP COLUMN weight;
+ALTER TABLE orders
+    DROP CONSTRAINT chk_orders_weight,
+    DROP COLUMN weight;
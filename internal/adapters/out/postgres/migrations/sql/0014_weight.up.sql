@@ -0,0 +1,9 @@
+-- Weight is in grams, zero means it is not known or not limited
+ALTER TABLE orders ADD COLUMN weight integer NOT NULL DEFAULT 0;
+ALTER TABLE order_items ADD COLUMN weight integer NOT NULL DEFAULT 0;
+ALTER TABLE couriers ADD COLUMN max_payload integer NOT NULL DEFAULT 0;
+ALTER TABLE storage_places ADD COLUMN order_weight integer NOT NULL DEFAULT 0;
+
+ALTER TABLE orders ADD CONSTRAINT chk_orders_weight CHECK (weight >= 0);
+ALTER TABLE order_items ADD CONSTRAINT chk_order_items_weight CHECK (weight >= 0);
+ALTER TABLE couriers ADD CONSTRAINT chk_couriers_max_payload CHECK (max_payload >= 0);
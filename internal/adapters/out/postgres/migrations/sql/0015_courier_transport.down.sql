@@ -0,0 +1,3 @@
+ALTER TABLE couriers
+    DROP CONSTRAINT chk_couriers_transport,
+    DROP COLUMN transport;
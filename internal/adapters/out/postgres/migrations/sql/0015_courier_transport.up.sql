@@ -0,0 +1,5 @@
+-- Couriers created before transport types had a bag and no zone limits, like cars
+ALTER TABLE couriers ADD COLUMN transport varchar(20) NOT NULL DEFAULT 'Car';
+ALTER TABLE couriers ALTER COLUMN transport DROP DEFAULT;
+ALTER TABLE couriers
+    ADD CONSTRAINT chk_couriers_transport CHECK (transport IN ('Pedestrian', 'Bicycle', 'Scooter', 'Car'));
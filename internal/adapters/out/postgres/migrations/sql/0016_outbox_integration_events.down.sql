@@ -0,0 +1 @@
+-- Skipped internal events are not restored, they were never sent
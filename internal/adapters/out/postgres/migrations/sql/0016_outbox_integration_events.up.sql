@@ -0,0 +1,13 @@
+-- Internal domain events are no longer published, the relay would stop on them
+UPDATE outbox
+SET processed_at_utc = now()
+WHERE processed_at_utc IS NULL
+  AND name NOT IN (
+    'OrderCreatedDomainEvent',
+    'OrderAssignedDomainEvent',
+    'OrderPickedUpDomainEvent',
+    'OrderCompletedDomainEvent',
+    'OrderCancelledDomainEvent',
+    'OrderDeliveryFailedDomainEvent',
+    'OrderReturnedToSenderDomainEvent'
+  );
@@ -0,0 +1 @@
+ALTER TABLE courier_stops DROP COLUMN not_before;
@@ -0,0 +1,2 @@
+-- Failed drop-offs are visited again not before this time, NULL means any time
+ALTER TABLE courier_stops ADD COLUMN not_before timestamptz;
@@ -2,6 +2,7 @@ package orderrepo
 
 import (
 	"delivery/internal/core/domain/model/order"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -9,16 +10,65 @@ import (
 type OrderDTO struct {
 	ID        uuid.UUID   `gorm:"type:uuid;primaryKey"`
 	CourierID *uuid.UUID  `gorm:"type:uuid;index"`
+	Address   AddressDTO  `gorm:"embedded;embeddedPrefix:address_"`
+	Pickup    LocationDTO `gorm:"embedded;embeddedPrefix:pickup_"`
 	Location  LocationDTO `gorm:"embedded;embeddedPrefix:location_"`
 	Volume    int
+	Weight    int
 	Status    order.Status `gorm:"type:varchar(20)"`
+	Version   int64        `gorm:"not null;default:0"`
+
+	DeliveryFrom         *time.Time
+	DeliveryTo           *time.Time
+	DeliveryPeriodMissed bool `gorm:"not null;default:false"`
+
+	Items            []*ItemDTO            `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE;"`
+	DeliveryAttempts []*DeliveryAttemptDTO `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE;"`
+}
+
+// ItemDTO is a manifest line, Number keeps the order of lines as in the basket
+type ItemDTO struct {
+	OrderID  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Number   int       `gorm:"primaryKey"`
+	GoodID   uuid.UUID `gorm:"type:uuid"`
+	Title    string    `gorm:"type:varchar(255)"`
+	Price    int64
+	Quantity int
+	Weight   int
+	Frozen   bool
+	Fragile  bool
+}
+
+type DeliveryAttemptDTO struct {
+	OrderID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Number      int       `gorm:"primaryKey"`
+	Reason      string    `gorm:"type:varchar(30)"`
+	AttemptedAt time.Time
+}
+
+type AddressDTO struct {
+	Country   string
+	City      string
+	Street    string
+	House     string
+	Apartment string
 }
 
 type LocationDTO struct {
-	X int
-	Y int
+	X         int
+	Y         int
+	Latitude  *float64
+	Longitude *float64
 }
 
 func (OrderDTO) TableName() string {
 	return "orders"
 }
+
+func (ItemDTO) TableName() string {
+	return "order_items"
+}
+
+func (DeliveryAttemptDTO) TableName() string {
+	return "order_delivery_attempts"
+}
@@ -4,24 +4,108 @@ package orderrepo
 import (
 	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/order"
+	"sort"
 )
 
 func DomainToDTO(aggregate *order.Order) OrderDTO {
 	var orderDTO OrderDTO
 	orderDTO.ID = aggregate.ID()
 	orderDTO.CourierID = aggregate.CourierID()
-	orderDTO.Location = LocationDTO{
-		X: int(aggregate.Location().X()),
-		Y: int(aggregate.Location().Y()),
+	orderDTO.Address = AddressDTO{
+		Country:   aggregate.Address().Country(),
+		City:      aggregate.Address().City(),
+		Street:    aggregate.Address().Street(),
+		House:     aggregate.Address().House(),
+		Apartment: aggregate.Address().Apartment(),
 	}
+	orderDTO.Pickup = LocationDomainToDTO(aggregate.Pickup())
+	orderDTO.Location = LocationDomainToDTO(aggregate.Location())
 	orderDTO.Volume = int(aggregate.Volume())
+	orderDTO.Weight = aggregate.Weight().Grams()
 	orderDTO.Status = aggregate.Status()
+	orderDTO.Version = aggregate.Version()
+	if period := aggregate.DeliveryPeriod(); !period.IsEmpty() {
+		from, to := period.From(), period.To()
+		orderDTO.DeliveryFrom = &from
+		orderDTO.DeliveryTo = &to
+	}
+	orderDTO.DeliveryPeriodMissed = aggregate.IsDeliveryPeriodMissed()
+	for i, item := range aggregate.Items() {
+		orderDTO.Items = append(orderDTO.Items, &ItemDTO{
+			OrderID:  orderDTO.ID,
+			Number:   i + 1,
+			GoodID:   item.GoodID(),
+			Title:    item.Title(),
+			Price:    item.Price(),
+			Quantity: item.Quantity(),
+			Weight:   item.Weight().Grams(),
+			Frozen:   item.IsFrozen(),
+			Fragile:  item.IsFragile(),
+		})
+	}
+	for _, attempt := range aggregate.DeliveryAttempts() {
+		orderDTO.DeliveryAttempts = append(orderDTO.DeliveryAttempts, &DeliveryAttemptDTO{
+			OrderID:     orderDTO.ID,
+			Number:      attempt.Number(),
+			Reason:      attempt.Reason().String(),
+			AttemptedAt: attempt.AttemptedAt(),
+		})
+	}
 	return orderDTO
 }
 
 func DtoToDomain(dto OrderDTO) *order.Order {
 	var aggregate *order.Order
-	location, _ := kernel.NewLocation(uint8(dto.Location.X), uint8(dto.Location.Y))
-	aggregate = order.RestoreOrder(dto.ID, dto.CourierID, location, kernel.Volume(dto.Volume), dto.Status)
+	address := kernel.RestoreAddress(
+		dto.Address.Country, dto.Address.City, dto.Address.Street, dto.Address.House, dto.Address.Apartment,
+	)
+	pickup := LocationDtoToDomain(dto.Pickup)
+	location := LocationDtoToDomain(dto.Location)
+	sort.Slice(dto.Items, func(i, j int) bool {
+		return dto.Items[i].Number < dto.Items[j].Number
+	})
+	items := make([]order.Item, 0, len(dto.Items))
+	for _, item := range dto.Items {
+		items = append(items, order.RestoreItem(
+			item.GoodID, item.Title, item.Price, item.Quantity, kernel.Weight(item.Weight), item.Frozen, item.Fragile,
+		))
+	}
+	sort.Slice(dto.DeliveryAttempts, func(i, j int) bool {
+		return dto.DeliveryAttempts[i].Number < dto.DeliveryAttempts[j].Number
+	})
+	attempts := make([]order.DeliveryAttempt, 0, len(dto.DeliveryAttempts))
+	for _, attempt := range dto.DeliveryAttempts {
+		attempts = append(attempts, order.RestoreDeliveryAttempt(
+			attempt.Number, order.FailureReason(attempt.Reason), attempt.AttemptedAt,
+		))
+	}
+	var deliveryPeriod kernel.DeliveryPeriod
+	if dto.DeliveryFrom != nil && dto.DeliveryTo != nil {
+		deliveryPeriod, _ = kernel.NewDeliveryPeriod(*dto.DeliveryFrom, *dto.DeliveryTo)
+	}
+	aggregate = order.RestoreOrder(
+		dto.ID, dto.CourierID, address, items, pickup, location, kernel.Volume(dto.Volume), kernel.Weight(dto.Weight),
+		dto.Status, attempts, deliveryPeriod, dto.DeliveryPeriodMissed, dto.Version,
+	)
 	return aggregate
 }
+
+func LocationDomainToDTO(location kernel.Location) LocationDTO {
+	if location.IsGeo() {
+		latitude, longitude := location.Latitude(), location.Longitude()
+		return LocationDTO{Latitude: &latitude, Longitude: &longitude}
+	}
+	return LocationDTO{
+		X: location.X(),
+		Y: location.Y(),
+	}
+}
+
+func LocationDtoToDomain(dto LocationDTO) kernel.Location {
+	if dto.Latitude != nil && dto.Longitude != nil {
+		location, _ := kernel.NewGeoLocation(*dto.Latitude, *dto.Longitude)
+		return location
+	}
+	location, _ := kernel.NewLocation(dto.X, dto.Y)
+	return location
+}
@@ -30,7 +30,7 @@ func NewRepository(tracker Tracker) (*Repository, error) {
 }
 
 func (r *Repository) Add(ctx context.Context, aggregate *order.Order) error {
-	// r.tracker.Track(aggregate)
+	r.tracker.Track(aggregate)
 
 	dto := DomainToDTO(aggregate)
 
@@ -61,7 +61,7 @@ func (r *Repository) Add(ctx context.Context, aggregate *order.Order) error {
 }
 
 func (r *Repository) Update(ctx context.Context, aggregate *order.Order) error {
-	// r.tracker.Track(aggregate)
+	r.tracker.Track(aggregate)
 
 	dto := DomainToDTO(aggregate)
 
@@ -75,12 +75,33 @@ func (r *Repository) Update(ctx context.Context, aggregate *order.Order) error {
 		return errs.NewValueIsRequiredError("transaction not initialized")
 	}
 
-	// Вносим изменения
-	err := tx.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Save(&dto).Error
-	if err != nil {
-		return err
+	// Вносим изменения, только если заказ не изменили с момента загрузки
+	expectedVersion := aggregate.Version()
+	dto.Version = expectedVersion + 1
+	result := tx.WithContext(ctx).
+		Model(&OrderDTO{ID: dto.ID}).
+		Where("version = ?", expectedVersion).
+		Select("*").
+		Omit(clause.Associations).
+		Updates(&dto)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.NewVersionIsInvalidError("order")
 	}
 
+	// История попыток только дополняется, записанные попытки не меняются
+	if len(dto.DeliveryAttempts) > 0 {
+		err := tx.WithContext(ctx).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(dto.DeliveryAttempts).Error
+		if err != nil {
+			return err
+		}
+	}
+	aggregate.SetVersion(dto.Version)
+
 	// Если не было внешней в транзакции, то коммитим изменения
 	if !isInTransaction {
 		err := r.tracker.Commit(ctx)
@@ -125,19 +146,43 @@ func (r *Repository) GetFirstInCreatedStatus(ctx context.Context) (*order.Order,
 	return aggregate, nil
 }
 
-func (r *Repository) GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error) {
+func (r *Repository) GetAllInCreatedStatus(ctx context.Context) ([]*order.Order, error) {
+	var dtos []OrderDTO
+
+	tx := r.getTxOrDB()
+	result := tx.WithContext(ctx).
+		Preload(clause.Associations).
+		Where("status = ?", order.StatusCreated).
+		Find(&dtos)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errs.NewObjectNotFoundError("Created orders", nil)
+	}
+
+	aggregates := make([]*order.Order, len(dtos))
+	for i, dto := range dtos {
+		aggregates[i] = DtoToDomain(dto)
+	}
+
+	return aggregates, nil
+}
+
+// GetAllInDelivery returns orders which have a courier and are not delivered yet
+func (r *Repository) GetAllInDelivery(ctx context.Context) ([]*order.Order, error) {
 	var dtos []OrderDTO
 
 	tx := r.getTxOrDB()
 	result := tx.WithContext(ctx).
 		Preload(clause.Associations).
-		Where("status = ?", order.StatusAssigned).
+		Where("status IN (?, ?, ?)", order.StatusAssigned, order.StatusPickedUp, order.StatusDeliveryFailed).
 		Find(&dtos)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	if result.RowsAffected == 0 {
-		return nil, errs.NewObjectNotFoundError("Assigned orders", nil)
+		return nil, errs.NewObjectNotFoundError("orders in delivery", nil)
 	}
 
 	aggregates := make([]*order.Order, len(dtos))
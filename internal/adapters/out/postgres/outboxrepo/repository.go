@@ -0,0 +1,50 @@
+// Package outboxrepo
+package outboxrepo
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/outbox"
+
+	"gorm.io/gorm"
+)
+
+// BatchSize limits how many messages are published by a single relay run
+const BatchSize = 20
+
+var _ ports.OutboxRepository = &Repository{}
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*Repository, error) {
+	if db == nil {
+		return nil, errs.NewValueIsRequiredError("db")
+	}
+
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+func (r *Repository) GetNotPublishedMessages(ctx context.Context) ([]*outbox.Message, error) {
+	var messages []*outbox.Message
+	result := r.db.WithContext(ctx).
+		Where("processed_at_utc IS NULL").
+		Order("occurred_at_utc ASC").
+		Limit(BatchSize).
+		Find(&messages)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return messages, nil
+}
+
+func (r *Repository) Update(ctx context.Context, message *outbox.Message) error {
+	if message == nil {
+		return errs.NewValueIsRequiredError("message")
+	}
+	return r.db.WithContext(ctx).Save(message).Error
+}
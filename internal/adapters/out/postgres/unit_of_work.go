@@ -8,10 +8,14 @@ import (
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/inbox"
+	"delivery/internal/pkg/outbox"
 	"errors"
+	"time"
 
 	"github.com/labstack/gommon/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var _ ports.UnitOfWork = &UnitOfWork{}
@@ -23,15 +27,25 @@ type UnitOfWork struct {
 	trackedAggregates []ddd.AggregateRoot
 	courierRepository ports.CourierRepository
 	orderRepository   ports.OrderRepository
+	mediatr           ddd.Mediatr
+	eventRegistry     outbox.EventRegistry
 }
 
-func NewUnitOfWork(db *gorm.DB) (ports.UnitOfWork, error) {
+func NewUnitOfWork(db *gorm.DB, mediatr ddd.Mediatr, eventRegistry outbox.EventRegistry) (ports.UnitOfWork, error) {
 	if db == nil {
 		return nil, errs.NewValueIsRequiredError("db")
 	}
+	if mediatr == nil {
+		return nil, errs.NewValueIsRequiredError("mediatr")
+	}
+	if eventRegistry == nil {
+		return nil, errs.NewValueIsRequiredError("eventRegistry")
+	}
 
 	uow := &UnitOfWork{
-		db: db,
+		db:            db,
+		mediatr:       mediatr,
+		eventRegistry: eventRegistry,
 	}
 
 	courierRepo, err := courierrepo.NewRepository(uow)
@@ -62,6 +76,11 @@ func (u *UnitOfWork) InTx() bool {
 }
 
 func (u *UnitOfWork) Track(agg ddd.AggregateRoot) {
+	for _, tracked := range u.trackedAggregates {
+		if tracked == agg {
+			return
+		}
+	}
 	u.trackedAggregates = append(u.trackedAggregates, agg)
 }
 
@@ -88,12 +107,28 @@ func (u *UnitOfWork) Commit(ctx context.Context) error {
 		return errs.NewValueIsRequiredError("cannot commit without transaction")
 	}
 
+	// Handled integration event and domain events are stored in the same transaction as the aggregates
+	if err := u.saveMessageToInbox(ctx); err != nil {
+		return err
+	}
+	if err := u.saveDomainEventsToOutbox(ctx); err != nil {
+		return err
+	}
+
 	if err := u.tx.WithContext(ctx).Commit().Error; err != nil {
 		return err
 	}
 
+	var domainEvents []ddd.DomainEvent
+	for _, agg := range u.trackedAggregates {
+		domainEvents = append(domainEvents, agg.GetDomainEvents()...)
+		agg.ClearDomainEvents()
+	}
+
 	u.committed = true
 	u.clearTx()
+
+	u.publishDomainEvents(ctx, domainEvents)
 	return nil
 }
 
@@ -106,6 +141,56 @@ func (u *UnitOfWork) RollbackUnlessCommitted(ctx context.Context) {
 	}
 }
 
+// saveMessageToInbox fails the commit if the integration event in the context
+// has been handled before, so that it is not applied twice
+func (u *UnitOfWork) saveMessageToInbox(ctx context.Context) error {
+	message, ok := inbox.MessageFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	message.ProcessedAtUtc = time.Now().UTC()
+	result := u.tx.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&message)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return inbox.ErrAlreadyProcessed
+	}
+	return nil
+}
+
+// saveDomainEventsToOutbox keeps only the events published to other services,
+// internal ones are handled in process by publishDomainEvents
+func (u *UnitOfWork) saveDomainEventsToOutbox(ctx context.Context) error {
+	var messages []outbox.Message
+	for _, agg := range u.trackedAggregates {
+		for _, event := range agg.GetDomainEvents() {
+			if !u.eventRegistry.IsRegistered(event) {
+				continue
+			}
+			message, err := outbox.EncodeDomainEvent(event)
+			if err != nil {
+				return err
+			}
+			messages = append(messages, message)
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return u.tx.WithContext(ctx).Create(&messages).Error
+}
+
+// publishDomainEvents notifies in-process handlers. Changes are already
+// committed at this point, so handler errors are only logged
+func (u *UnitOfWork) publishDomainEvents(ctx context.Context, domainEvents []ddd.DomainEvent) {
+	for _, event := range domainEvents {
+		if err := u.mediatr.Publish(ctx, event); err != nil {
+			log.Errorf("Failed to handle domain event %s: %v", event.GetName(), err)
+		}
+	}
+}
+
 func (u *UnitOfWork) clearTx() {
 	u.tx = nil
 	u.trackedAggregates = nil
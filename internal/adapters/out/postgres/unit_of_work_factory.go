@@ -3,23 +3,33 @@ package postgres
 import (
 	"context"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/outbox"
 
 	"gorm.io/gorm"
 )
 
 type unitOfWorkFactory struct {
-	db *gorm.DB
+	db            *gorm.DB
+	mediatr       ddd.Mediatr
+	eventRegistry outbox.EventRegistry
 }
 
-func NewUnitOfWorkFactory(db *gorm.DB) (ports.UnitOfWorkFactory, error) {
+func NewUnitOfWorkFactory(db *gorm.DB, mediatr ddd.Mediatr, eventRegistry outbox.EventRegistry) (ports.UnitOfWorkFactory, error) {
 	if db == nil {
 		return nil, errs.NewValueIsInvalidError("db")
 	}
+	if mediatr == nil {
+		return nil, errs.NewValueIsInvalidError("mediatr")
+	}
+	if eventRegistry == nil {
+		return nil, errs.NewValueIsInvalidError("eventRegistry")
+	}
 
-	return &unitOfWorkFactory{db: db}, nil
+	return &unitOfWorkFactory{db: db, mediatr: mediatr, eventRegistry: eventRegistry}, nil
 }
 
 func (f *unitOfWorkFactory) New(ctx context.Context) (ports.UnitOfWork, error) {
-	return NewUnitOfWork(f.db.WithContext(ctx))
+	return NewUnitOfWork(f.db.WithContext(ctx), f.mediatr, f.eventRegistry)
 }
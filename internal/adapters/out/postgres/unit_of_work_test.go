@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"delivery/internal/adapters/out/postgres/migrations"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/outbox"
+	"delivery/internal/pkg/testcnts"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	gormpostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// committedCourierHandler records courier events together with
+// whether the courier could already be read outside the unit of work
+type committedCourierHandler struct {
+	db        *gorm.DB
+	events    []ddd.DomainEvent
+	committed []bool
+}
+
+func (h *committedCourierHandler) Handle(ctx context.Context, event ddd.DomainEvent) error {
+	created := event.(*courier.CourierCreatedDomainEvent)
+	var count int64
+	err := h.db.WithContext(ctx).Table("couriers").Where("id = ?", created.CourierID).Count(&count).Error
+	if err != nil {
+		return err
+	}
+	h.events = append(h.events, event)
+	h.committed = append(h.committed, count == 1)
+	return nil
+}
+
+func setupUnitOfWork(t *testing.T) (ports.UnitOfWork, *committedCourierHandler) {
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+	ctx := context.Background()
+	container, dsn, err := testcnts.StartPostgresContainer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	db, err := gorm.Open(gormpostgres.New(gormpostgres.Config{DSN: dsn, PreferSimpleProtocol: true}), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	migrator, err := migrations.NewMigrator(sqlDB)
+	require.NoError(t, err)
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+
+	handler := &committedCourierHandler{db: db}
+	mediatr := ddd.NewMediatr()
+	mediatr.Subscribe(handler, &courier.CourierCreatedDomainEvent{})
+	registry, err := outbox.NewEventRegistry()
+	require.NoError(t, err)
+
+	uow, err := NewUnitOfWork(db, mediatr, registry)
+	require.NoError(t, err)
+	return uow, handler
+}
+
+func Test_UnitOfWorkPublishesDomainEventsAfterCommit(t *testing.T) {
+	// Arrange
+	uow, handler := setupUnitOfWork(t)
+	ctx := context.Background()
+	c, err := courier.NewCourier("Bob", 2, kernel.MinLocation())
+	require.NoError(t, err)
+
+	// Act
+	uow.Begin(ctx)
+	err = uow.CourierRepository().Add(ctx, c)
+	require.NoError(t, err)
+	publishedBeforeCommit := len(handler.events)
+	err = uow.Commit(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Zero(t, publishedBeforeCommit, "events should not be published inside the transaction")
+	assert.Len(t, handler.events, 1, "courier created event should be published once")
+	assert.Equal(t, []bool{true}, handler.committed, "handler should see the committed courier")
+	assert.Empty(t, c.GetDomainEvents(), "published events should be cleared from the aggregate")
+}
+
+func Test_UnitOfWorkDoesNotPublishDomainEventsAfterRollback(t *testing.T) {
+	// Arrange
+	uow, handler := setupUnitOfWork(t)
+	ctx := context.Background()
+	c, err := courier.NewCourier("Bob", 2, kernel.MinLocation())
+	require.NoError(t, err)
+
+	// Act
+	err = func() error {
+		uow.Begin(ctx)
+		defer uow.RollbackUnlessCommitted(ctx)
+		if err := uow.CourierRepository().Add(ctx, c); err != nil {
+			return err
+		}
+		return errors.New("command failed")
+	}()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Empty(t, handler.events, "events of a rolled back unit of work should not be published")
+}
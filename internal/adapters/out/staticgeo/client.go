@@ -0,0 +1,64 @@
+// Package staticgeo geocodes addresses without the geo service
+package staticgeo
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"hash/fnv"
+	"strings"
+)
+
+var _ ports.GeoClient = &Client{}
+
+// Client looks addresses up in a static table of streets. Unknown streets get
+// a location derived from the address hash, so the same address always lands at the same point
+type Client struct {
+	streets map[string]kernel.Location
+}
+
+// NewClient takes the table keyed by tableKey, an empty table is allowed
+func NewClient(streets map[string]kernel.Location) (*Client, error) {
+	if streets == nil {
+		return nil, errs.NewValueIsRequiredError("streets")
+	}
+	return &Client{streets: streets}, nil
+}
+
+// GetGeoLocation prefers the street of the address city over the street known in any city
+func (c *Client) GetGeoLocation(_ context.Context, address kernel.Address) (kernel.Location, error) {
+	if location, ok := c.streets[tableKey(address.City(), address.Street())]; ok {
+		return location, nil
+	}
+	if location, ok := c.streets[tableKey("", address.Street())]; ok {
+		return location, nil
+	}
+	return hashLocation(address)
+}
+
+func tableKey(city, street string) string {
+	return strings.ToLower(strings.TrimSpace(city)) + "|" + strings.ToLower(strings.TrimSpace(street))
+}
+
+func hashLocation(address kernel.Address) (kernel.Location, error) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(strings.ToLower(address.String())))
+	sum := h.Sum64()
+	if kernel.GeoCoordinatesEnabled() {
+		southWest, northEast := kernel.CurrentGeoArea().SouthWest(), kernel.CurrentGeoArea().NorthEast()
+		// Two independent fractions of the area taken from the halves of the hash
+		fractionLat := float64(sum>>32) / float64(1<<32)
+		fractionLon := float64(sum&(1<<32-1)) / float64(1<<32)
+		return kernel.NewGeoLocation(
+			southWest.Latitude()+fractionLat*(northEast.Latitude()-southWest.Latitude()),
+			southWest.Longitude()+fractionLon*(northEast.Longitude()-southWest.Longitude()),
+		)
+	}
+	grid := kernel.CurrentGrid()
+	width := uint64(grid.MaxX() - kernel.MinX + 1)
+	height := uint64(grid.MaxY() - kernel.MinY + 1)
+	x := kernel.MinX + int(sum%width)
+	y := kernel.MinY + int((sum/width)%height)
+	return kernel.NewLocation(x, y)
+}
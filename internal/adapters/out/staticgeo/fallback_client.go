@@ -0,0 +1,55 @@
+package staticgeo
+
+import (
+	"context"
+	grpcgeo "delivery/internal/adapters/out/grpc/geo"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ ports.GeoClient = &FallbackClient{}
+
+// FallbackClient keeps order intake going in degraded mode: when the primary geocoder
+// is down, the location is taken from the fallback one
+type FallbackClient struct {
+	primary  ports.GeoClient
+	fallback ports.GeoClient
+}
+
+func NewFallbackClient(primary, fallback ports.GeoClient) (*FallbackClient, error) {
+	if primary == nil {
+		return nil, errs.NewValueIsRequiredError("primary")
+	}
+	if fallback == nil {
+		return nil, errs.NewValueIsRequiredError("fallback")
+	}
+	return &FallbackClient{primary: primary, fallback: fallback}, nil
+}
+
+func (c *FallbackClient) GetGeoLocation(ctx context.Context, address kernel.Address) (kernel.Location, error) {
+	location, err := c.primary.GetGeoLocation(ctx, address)
+	if err == nil || ctx.Err() != nil || !isDown(err) {
+		return location, err
+	}
+	log.Printf("Geocoding %q in degraded mode: %v", address.String(), err)
+	return c.fallback.GetGeoLocation(ctx, address)
+}
+
+// isDown tells failures of the geo service itself. Other errors, such as an unknown address,
+// are answers of a working service and are returned to the caller
+func isDown(err error) bool {
+	if errors.Is(err, grpcgeo.ErrCircuitIsOpen) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
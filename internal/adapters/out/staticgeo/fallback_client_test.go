@@ -0,0 +1,82 @@
+package staticgeo
+
+import (
+	"context"
+	grpcgeo "delivery/internal/adapters/out/grpc/geo"
+	"delivery/internal/core/domain/kernel"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type failingGeoClient struct {
+	err error
+}
+
+func (c *failingGeoClient) GetGeoLocation(context.Context, kernel.Address) (kernel.Location, error) {
+	return kernel.Location{}, c.err
+}
+
+func Test_FallbackClientFallsBackWhenGeoServiceIsDown(t *testing.T) {
+	tests := map[string]error{
+		"circuit is open":   fmt.Errorf("geocode: %w", grpcgeo.ErrCircuitIsOpen),
+		"unavailable":       status.Error(codes.Unavailable, "down"),
+		"deadline exceeded": status.Error(codes.DeadlineExceeded, "timeout"),
+	}
+	for name, primaryErr := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			fallback, _ := NewClient(map[string]kernel.Location{tableKey("", "Tverskaya"): kernel.MaxLocation()})
+			client, _ := NewFallbackClient(&failingGeoClient{err: primaryErr}, fallback)
+			address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+
+			// Act
+			location, err := client.GetGeoLocation(context.Background(), address)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, kernel.MaxLocation(), location)
+		})
+	}
+}
+
+func Test_FallbackClientReturnsAnswersOfWorkingGeoService(t *testing.T) {
+	tests := map[string]error{
+		"not found":        status.Error(codes.NotFound, "no such address"),
+		"invalid argument": status.Error(codes.InvalidArgument, "empty street"),
+		"not grpc":         errors.New("invalid location"),
+	}
+	for name, primaryErr := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			fallback, _ := NewClient(map[string]kernel.Location{})
+			client, _ := NewFallbackClient(&failingGeoClient{err: primaryErr}, fallback)
+			address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+
+			// Act
+			_, err := client.GetGeoLocation(context.Background(), address)
+
+			// Assert
+			assert.ErrorIs(t, err, primaryErr)
+		})
+	}
+}
+
+func Test_FallbackClientDoesNotFallBackWhenCallerGaveUp(t *testing.T) {
+	// Arrange
+	fallback, _ := NewClient(map[string]kernel.Location{})
+	client, _ := NewFallbackClient(&failingGeoClient{err: status.Error(codes.DeadlineExceeded, "timeout")}, fallback)
+	address := kernel.RestoreAddress("Russia", "Moscow", "Tverskaya", "1", "")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	_, err := client.GetGeoLocation(ctx, address)
+
+	// Assert
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
@@ -0,0 +1,73 @@
+package staticgeo
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// LoadTable reads a CSV file with "city,street,x,y" lines, or "city,street,latitude,longitude"
+// when geo coordinates are on. Empty city means the street is the same in any city,
+// lines starting with # are comments
+func LoadTable(path string) (map[string]kernel.Location, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open streets table: %w", err)
+	}
+	defer file.Close()
+
+	return ReadTable(file)
+}
+
+func ReadTable(r io.Reader) (map[string]kernel.Location, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	streets := make(map[string]kernel.Location)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return streets, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read streets table: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		location, err := parseLocation(strings.TrimSpace(record[2]), strings.TrimSpace(record[3]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		streets[tableKey(record[0], record[1])] = location
+	}
+}
+
+func parseLocation(first, second string) (kernel.Location, error) {
+	if kernel.GeoCoordinatesEnabled() {
+		latitude, err := strconv.ParseFloat(first, 64)
+		if err != nil {
+			return kernel.Location{}, fmt.Errorf("invalid latitude: %w", err)
+		}
+		longitude, err := strconv.ParseFloat(second, 64)
+		if err != nil {
+			return kernel.Location{}, fmt.Errorf("invalid longitude: %w", err)
+		}
+		return kernel.NewGeoLocation(latitude, longitude)
+	}
+	x, err := strconv.Atoi(first)
+	if err != nil {
+		return kernel.Location{}, fmt.Errorf("invalid x: %w", err)
+	}
+	y, err := strconv.Atoi(second)
+	if err != nil {
+		return kernel.Location{}, fmt.Errorf("invalid y: %w", err)
+	}
+	return kernel.NewLocation(x, y)
+}
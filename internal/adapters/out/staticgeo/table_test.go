@@ -0,0 +1,65 @@
+package staticgeo
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ReadTable(t *testing.T) {
+	// Arrange
+	table := "# city,street,x,y\n" +
+		"Moscow, Tverskaya, 2, 3\n" +
+		",Arbat,4,5\n"
+
+	// Act
+	streets, err := ReadTable(strings.NewReader(table))
+
+	// Assert
+	assert.NoError(t, err)
+	tverskaya, _ := kernel.NewLocation(2, 3)
+	arbat, _ := kernel.NewLocation(4, 5)
+	assert.Equal(t, map[string]kernel.Location{
+		tableKey("moscow", "tverskaya"): tverskaya,
+		tableKey("", "arbat"):           arbat,
+	}, streets)
+}
+
+func Test_ReadTableErrorWrongFieldsCount(t *testing.T) {
+	// Arrange
+	table := "Moscow,Tverskaya,2\n"
+
+	// Act
+	streets, err := ReadTable(strings.NewReader(table))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, streets)
+}
+
+func Test_ReadTableErrorInvalidLocation(t *testing.T) {
+	// Arrange
+	table := "Moscow,Tverskaya,2,3\n" +
+		"Moscow,Arbat,x,5\n"
+
+	// Act
+	streets, err := ReadTable(strings.NewReader(table))
+
+	// Assert
+	assert.ErrorContains(t, err, "line 2")
+	assert.Nil(t, streets)
+}
+
+func Test_ReadTableErrorLocationOutOfGrid(t *testing.T) {
+	// Arrange
+	table := "Moscow,Tverskaya,0,3\n"
+
+	// Act
+	streets, err := ReadTable(strings.NewReader(table))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, streets)
+}
@@ -0,0 +1,30 @@
+package eventhandlers
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/labstack/gommon/log"
+)
+
+var _ ddd.EventHandler = &OrderDeliveryPeriodMissedHandler{}
+
+// OrderDeliveryPeriodMissedHandler reports orders which will reach the customer after their delivery period.
+// The event is internal and is handled only after the unit of work has committed
+type OrderDeliveryPeriodMissedHandler struct{}
+
+func NewOrderDeliveryPeriodMissedHandler() *OrderDeliveryPeriodMissedHandler {
+	return &OrderDeliveryPeriodMissedHandler{}
+}
+
+func (h *OrderDeliveryPeriodMissedHandler) Handle(_ context.Context, event ddd.DomainEvent) error {
+	missed, ok := event.(*order.OrderDeliveryPeriodMissedDomainEvent)
+	if !ok {
+		return errs.NewValueIsInvalidError("event")
+	}
+	log.Warnf("Order %s in status %s misses its delivery period ending at %s",
+		missed.OrderID, missed.OrderStatus, missed.PeriodTo.Format("2006-01-02 15:04"))
+	return nil
+}
@@ -0,0 +1,37 @@
+package eventhandlers
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OrderDeliveryPeriodMissedHandlerIsCalledThroughMediatr(t *testing.T) {
+	// Arrange
+	mediatr := ddd.NewMediatr()
+	mediatr.Subscribe(NewOrderDeliveryPeriodMissedHandler(), &order.OrderDeliveryPeriodMissedDomainEvent{})
+	event := &order.OrderDeliveryPeriodMissedDomainEvent{OrderStatus: order.StatusAssigned.String()}
+
+	// Act
+	err := mediatr.Publish(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func Test_OrderDeliveryPeriodMissedHandlerRejectsOtherEvents(t *testing.T) {
+	// Arrange
+	c, _ := courier.NewCourier("Bob", 2, kernel.MinLocation())
+
+	// Act
+	err := NewOrderDeliveryPeriodMissedHandler().Handle(context.Background(), courier.NewCourierCreatedDomainEvent(c))
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
@@ -11,11 +12,13 @@ type AddStoragePlaceCommand struct {
 	courierID uuid.UUID
 	name      string
 	volume    kernel.Volume
+	equipment courier.Equipment
 
 	isValid bool
 }
 
-func NewAddStoragePlaceCommand(courierID uuid.UUID, name string, volume kernel.Volume) (AddStoragePlaceCommand, error) {
+func NewAddStoragePlaceCommand(courierID uuid.UUID, name string, volume kernel.Volume,
+	equipment courier.Equipment) (AddStoragePlaceCommand, error) {
 	if courierID == uuid.Nil {
 		return AddStoragePlaceCommand{}, errs.NewValueIsInvalidError("courierID")
 	}
@@ -30,6 +33,7 @@ func NewAddStoragePlaceCommand(courierID uuid.UUID, name string, volume kernel.V
 		courierID: courierID,
 		name: name,
 		volume: volume,
+		equipment: equipment,
 
 		isValid: true,
 	}, nil
@@ -50,3 +54,7 @@ func (c AddStoragePlaceCommand) Name() string {
 func (c AddStoragePlaceCommand) Volume() kernel.Volume {
 	return c.volume
 }
+
+func (c AddStoragePlaceCommand) Equipment() courier.Equipment {
+	return c.equipment
+}
@@ -31,6 +31,12 @@ func (h *addStoragePlaceHandler) Handle(ctx context.Context, command AddStorageP
 		return errs.NewValueIsInvalidError("command")
 	}
 
+	return retryOnConflict(ctx, func() error {
+		return h.handle(ctx, command)
+	})
+}
+
+func (h *addStoragePlaceHandler) handle(ctx context.Context, command AddStoragePlaceCommand) error {
 	uow, err := h.uowFactory.New(ctx)
 	if err != nil {
 		return err
@@ -48,7 +54,7 @@ func (h *addStoragePlaceHandler) Handle(ctx context.Context, command AddStorageP
 		return errs.NewObjectNotFoundError("courier", command.CourierID())
 	}
 
-	err = courierAggregate.AddStoragePlace(command.Name(), int(command.Volume()))
+	err = courierAggregate.AddEquippedStoragePlace(command.Name(), int(command.Volume()), command.Equipment())
 	if err != nil {
 		return err
 	}
@@ -2,9 +2,11 @@ package commands
 
 import (
 	"context"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
+	"errors"
 )
 
 type AssignOrderHandler interface {
@@ -39,6 +41,12 @@ func (h *assignOrderHandler) Handle(ctx context.Context, command AssignOrderComm
 		return errs.NewValueIsInvalidError("command")
 	}
 
+	return retryOnConflict(ctx, func() error {
+		return h.handle(ctx)
+	})
+}
+
+func (h *assignOrderHandler) handle(ctx context.Context) error {
 	uow, err := h.uowFactory.New(ctx)
 	if err != nil {
 		return err
@@ -48,25 +56,50 @@ func (h *assignOrderHandler) Handle(ctx context.Context, command AssignOrderComm
 	// Start transaction
 	uow.Begin(ctx)
 
-	order, err := uow.OrderRepository().GetFirstInCreatedStatus(ctx)
+	orders, err := uow.OrderRepository().GetAllInCreatedStatus(ctx)
 	if err != nil {
 		return err
 	}
-	availableCouriers, err := uow.CourierRepository().GetAllAvailable(ctx)
-	if err != nil {
-		return err
+
+	// Orders which have missed their delivery period are flagged, but still delivered
+	dueOrders := make([]*order.Order, 0, len(orders))
+	for _, o := range orders {
+		if o.CheckDeliveryPeriod(h.dispatcher.Now()) {
+			err = uow.OrderRepository().Update(ctx, o)
+			if err != nil {
+				return err
+			}
+		}
+		if h.dispatcher.IsDue(o) {
+			dueOrders = append(dueOrders, o)
+		}
 	}
-	assignedCourier, err := h.dispatcher.Dispatch(order, availableCouriers)
-	if err != nil {
-		return err
+	if len(dueOrders) == 0 {
+		return uow.Commit(ctx)
 	}
-	err = uow.OrderRepository().Update(ctx, order)
+
+	availableCouriers, err := uow.CourierRepository().GetAllAvailable(ctx)
 	if err != nil {
 		return err
 	}
-	err = uow.CourierRepository().Update(ctx, assignedCourier)
-	if err != nil {
-		return err
+	// The oldest order nobody can take must not block the orders behind it
+	for _, dueOrder := range dueOrders {
+		assignedCourier, err := h.dispatcher.Dispatch(dueOrder, availableCouriers)
+		if errors.Is(err, services.ErrCourierNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		err = uow.OrderRepository().Update(ctx, dueOrder)
+		if err != nil {
+			return err
+		}
+		err = uow.CourierRepository().Update(ctx, assignedCourier)
+		if err != nil {
+			return err
+		}
+		break
 	}
 	err = uow.Commit(ctx)
 	if err != nil {
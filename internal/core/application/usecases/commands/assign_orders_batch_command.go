@@ -0,0 +1,15 @@
+package commands
+
+type AssignOrdersBatchCommand struct {
+	isValid bool
+}
+
+func NewAssignOrdersBatchCommand() AssignOrdersBatchCommand {
+	return AssignOrdersBatchCommand{
+		isValid: true,
+	}
+}
+
+func (c AssignOrdersBatchCommand) IsValid() bool {
+	return c.isValid
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type AssignOrdersBatchHandler interface {
+	Handle(context.Context, AssignOrdersBatchCommand) (AssignOrdersBatchResult, error)
+}
+
+type UnassignedOrder struct {
+	OrderID uuid.UUID
+	Reason  string
+}
+
+type AssignOrdersBatchResult struct {
+	Assigned   int
+	Unassigned []UnassignedOrder
+}
+
+type assignOrdersBatchHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+	dispatcher services.OrderDispatcherService
+}
+
+var _ AssignOrdersBatchHandler = &assignOrdersBatchHandler{}
+
+func NewAssignOrdersBatchHandler(
+	uowFactory ports.UnitOfWorkFactory, dispatcher services.OrderDispatcherService,
+) (AssignOrdersBatchHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsInvalidError("uowFactory")
+	}
+	if dispatcher == nil {
+		return nil, errs.NewValueIsInvalidError("dispatcher")
+	}
+
+	return &assignOrdersBatchHandler{
+		uowFactory: uowFactory,
+		dispatcher: dispatcher,
+	}, nil
+}
+
+func (h *assignOrdersBatchHandler) Handle(
+	ctx context.Context, command AssignOrdersBatchCommand,
+) (AssignOrdersBatchResult, error) {
+	if !command.IsValid() {
+		return AssignOrdersBatchResult{}, errs.NewValueIsInvalidError("command")
+	}
+
+	var result AssignOrdersBatchResult
+	err := retryOnConflict(ctx, func() error {
+		var err error
+		result, err = h.handle(ctx)
+		return err
+	})
+	return result, err
+}
+
+func (h *assignOrdersBatchHandler) handle(ctx context.Context) (AssignOrdersBatchResult, error) {
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return AssignOrdersBatchResult{}, err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	// Start transaction
+	uow.Begin(ctx)
+
+	orders, err := uow.OrderRepository().GetAllInCreatedStatus(ctx)
+	if errors.Is(err, errs.ErrObjectNotFound) {
+		return AssignOrdersBatchResult{}, nil
+	}
+	if err != nil {
+		return AssignOrdersBatchResult{}, err
+	}
+
+	// Orders which have missed their delivery period are flagged, but still delivered
+	for _, o := range orders {
+		if o.CheckDeliveryPeriod(h.dispatcher.Now()) {
+			err = uow.OrderRepository().Update(ctx, o)
+			if err != nil {
+				return AssignOrdersBatchResult{}, err
+			}
+		}
+	}
+
+	result := AssignOrdersBatchResult{}
+	availableCouriers, err := uow.CourierRepository().GetAllAvailable(ctx)
+	if errors.Is(err, errs.ErrObjectNotFound) {
+		for _, o := range orders {
+			result.Unassigned = append(result.Unassigned, UnassignedOrder{
+				OrderID: o.ID(), Reason: services.ErrCourierNotFound.Error(),
+			})
+		}
+		return result, uow.Commit(ctx)
+	}
+	if err != nil {
+		return AssignOrdersBatchResult{}, err
+	}
+
+	dispatchResult, err := h.dispatcher.DispatchBatch(orders, availableCouriers)
+	if err != nil {
+		return AssignOrdersBatchResult{}, err
+	}
+
+	for _, assignment := range dispatchResult.Assigned {
+		err = uow.OrderRepository().Update(ctx, assignment.Order)
+		if err != nil {
+			return AssignOrdersBatchResult{}, err
+		}
+		err = uow.CourierRepository().Update(ctx, assignment.Courier)
+		if err != nil {
+			return AssignOrdersBatchResult{}, err
+		}
+	}
+
+	err = uow.Commit(ctx)
+	if err != nil {
+		return AssignOrdersBatchResult{}, err
+	}
+
+	result.Assigned = len(dispatchResult.Assigned)
+	for _, unassigned := range dispatchResult.Unassigned {
+		result.Unassigned = append(result.Unassigned, UnassignedOrder{
+			OrderID: unassigned.Order.ID(), Reason: unassigned.Reason.Error(),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type CancelOrderCommand struct {
+	orderID uuid.UUID
+
+	isValid bool
+}
+
+func NewCancelOrderCommand(orderID uuid.UUID) (CancelOrderCommand, error) {
+	if orderID == uuid.Nil {
+		return CancelOrderCommand{}, errs.NewValueIsInvalidError("orderID")
+	}
+
+	return CancelOrderCommand{
+		orderID: orderID,
+
+		isValid: true,
+	}, nil
+}
+
+func (c CancelOrderCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c CancelOrderCommand) OrderID() uuid.UUID {
+	return c.orderID
+}
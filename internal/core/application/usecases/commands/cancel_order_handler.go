@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type CancelOrderHandler interface {
+	Handle(context.Context, CancelOrderCommand) error
+}
+
+type cancelOrderHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+var _ CancelOrderHandler = &cancelOrderHandler{}
+
+func NewCancelOrderHandler(uowFactory ports.UnitOfWorkFactory) (CancelOrderHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsInvalidError("uowFactory")
+	}
+
+	return &cancelOrderHandler{
+		uowFactory: uowFactory,
+	}, nil
+}
+
+func (h *cancelOrderHandler) Handle(ctx context.Context, command CancelOrderCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("command")
+	}
+
+	return retryOnConflict(ctx, func() error {
+		return h.handle(ctx, command)
+	})
+}
+
+func (h *cancelOrderHandler) handle(ctx context.Context, command CancelOrderCommand) error {
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	// Start transaction
+	uow.Begin(ctx)
+
+	o, err := uow.OrderRepository().Get(ctx, command.OrderID())
+	if err != nil {
+		return err
+	}
+	if o == nil {
+		return errs.NewObjectNotFoundError("order", command.OrderID())
+	}
+	// The same cancellation may come from both API and Kafka
+	if o.Status() == order.StatusCancelled {
+		return nil
+	}
+
+	courierID := o.CourierID()
+	err = o.Cancel()
+	if err != nil {
+		return err
+	}
+	err = uow.OrderRepository().Update(ctx, o)
+	if err != nil {
+		return err
+	}
+
+	if courierID != nil {
+		c, err := uow.CourierRepository().Get(ctx, *courierID)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			return errs.NewObjectNotFoundError("courier", *courierID)
+		}
+		err = c.CancelOrder(o)
+		if err != nil {
+			return err
+		}
+		err = uow.CourierRepository().Update(ctx, c)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = uow.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
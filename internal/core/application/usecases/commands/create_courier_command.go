@@ -1,24 +1,40 @@
 package commands
 
 import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/pkg/errs"
 )
 
 type CreateCourierCommand struct {
-	name  string
-	speed int
+	name       string
+	transport  courier.Transport
+	speed      int
+	maxPayload *kernel.Weight
 
 	isValid bool
 }
 
-func NewCreateCourierCommand(name string, speed int) (CreateCourierCommand, error) {
+// NewCreateCourierCommand takes zero speed and nil max payload when the transport defaults should be used,
+// zero max payload means no weight limit
+func NewCreateCourierCommand(
+	name string, transport courier.Transport, speed int, maxPayload *kernel.Weight,
+) (CreateCourierCommand, error) {
 	if name == "" {
 		return CreateCourierCommand{}, errs.NewValueIsInvalidError("name")
 	}
+	if !transport.IsValid() {
+		return CreateCourierCommand{}, errs.NewValueIsInvalidError("transport")
+	}
+	if maxPayload != nil && *maxPayload < 0 {
+		return CreateCourierCommand{}, errs.NewValueIsInvalidError("maxPayload")
+	}
 
 	return CreateCourierCommand{
 		name: name,
+		transport: transport,
 		speed: speed,
+		maxPayload: maxPayload,
 
 		isValid: true,
 	}, nil
@@ -32,6 +48,17 @@ func (c CreateCourierCommand) Name() string {
 	return c.name
 }
 
+func (c CreateCourierCommand) Transport() courier.Transport {
+	return c.transport
+}
+
 func (c CreateCourierCommand) Speed() int {
 	return c.speed
 }
+
+func (c CreateCourierCommand) MaxPayload() (kernel.Weight, bool) {
+	if c.maxPayload == nil {
+		return 0, false
+	}
+	return *c.maxPayload, true
+}
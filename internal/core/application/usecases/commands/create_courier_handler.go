@@ -45,10 +45,16 @@ func (h *createCourierHandler) Handle(ctx context.Context, command CreateCourier
 		return err
 	}
 
-	courierAggregate, err := courier.NewCourier(command.Name(), command.Speed(), location)
+	courierAggregate, err := courier.NewCourierOnTransport(command.Name(), command.Transport(), command.Speed(), location)
 	if err != nil {
 		return err
 	}
+	if maxPayload, ok := command.MaxPayload(); ok {
+		err = courierAggregate.ChangeMaxPayload(maxPayload)
+		if err != nil {
+			return err
+		}
+	}
 
 	err = uow.CourierRepository().Add(ctx, courierAggregate)
 	if err != nil {
@@ -3,6 +3,7 @@ package commands
 
 import (
 	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 
 	"github.com/google/uuid"
@@ -10,27 +11,47 @@ import (
 
 type CreateOrderCommand struct {
 	orderID uuid.UUID
-	street string
+	address kernel.Address
+	items []order.Item
 	volume kernel.Volume
+	weight kernel.Weight
+	deliveryPeriod kernel.DeliveryPeriod
 
 	isValid bool
 }
 
-func NewCreateOrderCommand(orderID uuid.UUID, street string, volume kernel.Volume) (CreateOrderCommand, error) {
+// NewCreateOrderCommand takes an empty delivery period when the customer has not chosen a slot
+// and zero volume or weight when the basket did not report it, then it is derived from items
+func NewCreateOrderCommand(
+	orderID uuid.UUID, address kernel.Address, items []order.Item, volume kernel.Volume, weight kernel.Weight,
+	deliveryPeriod kernel.DeliveryPeriod,
+) (CreateOrderCommand, error) {
 	if orderID == uuid.Nil {
 		return CreateOrderCommand{}, errs.NewValueIsInvalidError("orderID")
 	}
-	if street == "" {
-		return CreateOrderCommand{}, errs.NewValueIsInvalidError("street")
+	if address.IsEmpty() {
+		return CreateOrderCommand{}, errs.NewValueIsInvalidError("address")
+	}
+	if volume == 0 {
+		volume = order.VolumeOfItems(items)
 	}
 	if !volume.IsValid() {
 		return CreateOrderCommand{}, errs.NewValueIsInvalidError("volume")
 	}
+	if weight < 0 {
+		return CreateOrderCommand{}, errs.NewValueIsInvalidError("weight")
+	}
+	if weight == 0 {
+		weight = order.WeightOfItems(items)
+	}
 
 	return CreateOrderCommand{
 		orderID: orderID,
-		street: street,
+		address: address,
+		items: items,
 		volume: volume,
+		weight: weight,
+		deliveryPeriod: deliveryPeriod,
 
 		isValid: true,
 	}, nil
@@ -44,10 +65,22 @@ func (c CreateOrderCommand) OrderID() uuid.UUID {
 	return c.orderID
 }
 
-func (c CreateOrderCommand) Street() string {
-	return c.street
+func (c CreateOrderCommand) Address() kernel.Address {
+	return c.address
+}
+
+func (c CreateOrderCommand) Items() []order.Item {
+	return c.items
 }
 
 func (c CreateOrderCommand) Volume() kernel.Volume {
 	return c.volume
 }
+
+func (c CreateOrderCommand) Weight() kernel.Weight {
+	return c.weight
+}
+
+func (c CreateOrderCommand) DeliveryPeriod() kernel.DeliveryPeriod {
+	return c.deliveryPeriod
+}
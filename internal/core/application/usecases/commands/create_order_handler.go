@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
@@ -14,6 +15,7 @@ type CreateOrderHandler interface {
 type createOrderHandler struct {
 	uowFactory ports.UnitOfWorkFactory
 	geoClient  ports.GeoClient
+	pickup     kernel.Location
 }
 
 var _ CreateOrderHandler = &createOrderHandler{}
@@ -21,14 +23,19 @@ var _ CreateOrderHandler = &createOrderHandler{}
 func NewCreateOrderHandler(
 	uowFactory ports.UnitOfWorkFactory,
 	geoClient ports.GeoClient,
+	pickup kernel.Location,
 ) (CreateOrderHandler, error) {
 	if uowFactory == nil {
 		return nil, errs.NewValueIsInvalidError("uowFactory")
 	}
+	if !pickup.IsValid() {
+		return nil, errs.NewValueIsInvalidError("pickup")
+	}
 
 	return &createOrderHandler{
 		uowFactory: uowFactory,
 		geoClient: geoClient,
+		pickup: pickup,
 	}, nil
 }
 
@@ -55,12 +62,14 @@ func (h *createOrderHandler) Handle(ctx context.Context, command CreateOrderComm
 	}
 
 	// location, err := kernel.RandomLocation()
-	location, err := h.geoClient.GetGeoLocation(ctx, command.Street())
+	location, err := h.geoClient.GetGeoLocation(ctx, command.Address())
 	if err != nil {
 		return err
 	}
 
-	orderAggregate, err = order.NewOrder(command.OrderID(), location, command.Volume())
+	orderAggregate, err = order.NewOrder(
+		command.OrderID(), command.Address(), command.Items(), h.pickup, location, command.Volume(), command.Weight(), command.DeliveryPeriod(),
+	)
 	if err != nil {
 		return err
 	}
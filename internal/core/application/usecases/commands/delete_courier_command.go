@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type DeleteCourierCommand struct {
+	courierID uuid.UUID
+
+	isValid bool
+}
+
+func NewDeleteCourierCommand(courierID uuid.UUID) (DeleteCourierCommand, error) {
+	if courierID == uuid.Nil {
+		return DeleteCourierCommand{}, errs.NewValueIsInvalidError("courierID")
+	}
+
+	return DeleteCourierCommand{
+		courierID: courierID,
+
+		isValid: true,
+	}, nil
+}
+
+func (c DeleteCourierCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c DeleteCourierCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
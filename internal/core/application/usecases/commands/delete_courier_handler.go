@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+// DeleteCourierHandler deactivates the courier, the record is kept for order history
+type DeleteCourierHandler interface {
+	Handle(context.Context, DeleteCourierCommand) error
+}
+
+type deleteCourierHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+var _ DeleteCourierHandler = &deleteCourierHandler{}
+
+func NewDeleteCourierHandler(uowFactory ports.UnitOfWorkFactory) (DeleteCourierHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsInvalidError("uowFactory")
+	}
+
+	return &deleteCourierHandler{
+		uowFactory: uowFactory,
+	}, nil
+}
+
+func (h *deleteCourierHandler) Handle(ctx context.Context, command DeleteCourierCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("command")
+	}
+
+	return retryOnConflict(ctx, func() error {
+		return h.handle(ctx, command)
+	})
+}
+
+func (h *deleteCourierHandler) handle(ctx context.Context, command DeleteCourierCommand) error {
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	// Start transaction
+	uow.Begin(ctx)
+
+	c, err := uow.CourierRepository().Get(ctx, command.CourierID())
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return errs.NewObjectNotFoundError("courier", command.CourierID())
+	}
+	// Repeated requests are fine, the courier is deleted already
+	if c.Status() == courier.StatusDeactivated {
+		return nil
+	}
+
+	// The courier is taken off shift on the way, it is refused while some orders are not delivered
+	if c.Status() == courier.StatusOnShift || c.Status() == courier.StatusOnBreak {
+		err = c.EndShift()
+		if err != nil {
+			return err
+		}
+	}
+	err = c.Deactivate()
+	if err != nil {
+		return err
+	}
+
+	err = uow.CourierRepository().Update(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	err = uow.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type EndShiftCommand struct {
+	courierID uuid.UUID
+
+	isValid bool
+}
+
+func NewEndShiftCommand(courierID uuid.UUID) (EndShiftCommand, error) {
+	if courierID == uuid.Nil {
+		return EndShiftCommand{}, errs.NewValueIsInvalidError("courierID")
+	}
+
+	return EndShiftCommand{
+		courierID: courierID,
+
+		isValid: true,
+	}, nil
+}
+
+func (c EndShiftCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c EndShiftCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type EndShiftHandler interface {
+	Handle(context.Context, EndShiftCommand) error
+}
+
+type endShiftHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+var _ EndShiftHandler = &endShiftHandler{}
+
+func NewEndShiftHandler(uowFactory ports.UnitOfWorkFactory) (EndShiftHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsInvalidError("uowFactory")
+	}
+
+	return &endShiftHandler{
+		uowFactory: uowFactory,
+	}, nil
+}
+
+func (h *endShiftHandler) Handle(ctx context.Context, command EndShiftCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("command")
+	}
+
+	return retryOnConflict(ctx, func() error {
+		return h.handle(ctx, command)
+	})
+}
+
+func (h *endShiftHandler) handle(ctx context.Context, command EndShiftCommand) error {
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	// Start transaction
+	uow.Begin(ctx)
+
+	c, err := uow.CourierRepository().Get(ctx, command.CourierID())
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return errs.NewObjectNotFoundError("courier", command.CourierID())
+	}
+	// Repeated requests are fine, the courier is off duty already
+	if c.Status() == courier.StatusOffDuty {
+		return nil
+	}
+
+	err = c.EndShift()
+	if err != nil {
+		return err
+	}
+	err = uow.CourierRepository().Update(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	err = uow.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// fakeUnitOfWork keeps aggregates in memory, changes are visible at once and commit always succeeds
+type fakeUnitOfWork struct {
+	couriers map[uuid.UUID]*courier.Courier
+	orders   map[uuid.UUID]*order.Order
+	commits  int
+}
+
+var (
+	_ ports.UnitOfWorkFactory = &fakeUnitOfWork{}
+	_ ports.UnitOfWork        = &fakeUnitOfWork{}
+	_ ports.CourierRepository = &fakeCourierRepository{}
+	_ ports.OrderRepository   = &fakeOrderRepository{}
+)
+
+func newFakeUnitOfWork(couriers []*courier.Courier, orders []*order.Order) *fakeUnitOfWork {
+	uow := &fakeUnitOfWork{
+		couriers: make(map[uuid.UUID]*courier.Courier),
+		orders:   make(map[uuid.UUID]*order.Order),
+	}
+	for _, c := range couriers {
+		uow.couriers[c.ID()] = c
+	}
+	for _, o := range orders {
+		uow.orders[o.ID()] = o
+	}
+	return uow
+}
+
+func (u *fakeUnitOfWork) New(context.Context) (ports.UnitOfWork, error) {
+	return u, nil
+}
+
+func (u *fakeUnitOfWork) Begin(context.Context) {}
+
+func (u *fakeUnitOfWork) Commit(context.Context) error {
+	u.commits++
+	return nil
+}
+
+func (u *fakeUnitOfWork) RollbackUnlessCommitted(context.Context) {}
+
+func (u *fakeUnitOfWork) CourierRepository() ports.CourierRepository {
+	return &fakeCourierRepository{uow: u}
+}
+
+func (u *fakeUnitOfWork) OrderRepository() ports.OrderRepository {
+	return &fakeOrderRepository{uow: u}
+}
+
+type fakeCourierRepository struct {
+	uow *fakeUnitOfWork
+}
+
+func (r *fakeCourierRepository) Add(_ context.Context, aggregate *courier.Courier) error {
+	r.uow.couriers[aggregate.ID()] = aggregate
+	return nil
+}
+
+func (r *fakeCourierRepository) Update(_ context.Context, aggregate *courier.Courier) error {
+	r.uow.couriers[aggregate.ID()] = aggregate
+	return nil
+}
+
+func (r *fakeCourierRepository) Get(_ context.Context, id uuid.UUID) (*courier.Courier, error) {
+	return r.uow.couriers[id], nil
+}
+
+func (r *fakeCourierRepository) GetAllAvailable(context.Context) ([]*courier.Courier, error) {
+	var couriers []*courier.Courier
+	for _, c := range r.uow.couriers {
+		if c.IsOnShift() {
+			couriers = append(couriers, c)
+		}
+	}
+	return couriers, nil
+}
+
+type fakeOrderRepository struct {
+	uow *fakeUnitOfWork
+}
+
+func (r *fakeOrderRepository) Add(_ context.Context, aggregate *order.Order) error {
+	r.uow.orders[aggregate.ID()] = aggregate
+	return nil
+}
+
+func (r *fakeOrderRepository) Update(_ context.Context, aggregate *order.Order) error {
+	r.uow.orders[aggregate.ID()] = aggregate
+	return nil
+}
+
+func (r *fakeOrderRepository) Get(_ context.Context, id uuid.UUID) (*order.Order, error) {
+	return r.uow.orders[id], nil
+}
+
+func (r *fakeOrderRepository) GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error) {
+	orders, _ := r.GetAllInCreatedStatus(ctx)
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	return orders[0], nil
+}
+
+func (r *fakeOrderRepository) GetAllInCreatedStatus(context.Context) ([]*order.Order, error) {
+	return r.withStatus(order.StatusCreated), nil
+}
+
+func (r *fakeOrderRepository) GetAllInDelivery(context.Context) ([]*order.Order, error) {
+	return r.withStatus(order.StatusAssigned, order.StatusPickedUp, order.StatusDeliveryFailed), nil
+}
+
+func (r *fakeOrderRepository) withStatus(statuses ...order.Status) []*order.Order {
+	var orders []*order.Order
+	for _, o := range r.uow.orders {
+		for _, status := range statuses {
+			if o.Status() == status {
+				orders = append(orders, o)
+			}
+		}
+	}
+	return orders
+}
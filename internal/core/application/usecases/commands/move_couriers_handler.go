@@ -2,27 +2,58 @@ package commands
 
 import (
 	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
 )
 
+// errHandoverSkipped means the handover service did not answer, the drop-off stays on the route
+// and is visited again on the next tick without counting an attempt
+var errHandoverSkipped = errors.New("handover skipped")
+
 type MoveCouriersHandler interface {
 	Handle(context.Context, MoveCouriersCommand) error
 }
 
 type moveCouriersHandler struct {
-	uowFactory ports.UnitOfWorkFactory
+	uowFactory          ports.UnitOfWorkFactory
+	handoverClient      ports.HandoverClient
+	maxDeliveryAttempts int
+	redeliveryDelay     time.Duration
 }
 
 var _ MoveCouriersHandler = &moveCouriersHandler{}
 
-func NewMoveCouriersHandler(uowFactory ports.UnitOfWorkFactory) (MoveCouriersHandler, error) {
+// NewMoveCouriersHandler takes redeliveryDelay, the time a failed drop-off is not visited again
+func NewMoveCouriersHandler(
+	uowFactory ports.UnitOfWorkFactory, handoverClient ports.HandoverClient, maxDeliveryAttempts int,
+	redeliveryDelay time.Duration,
+) (MoveCouriersHandler, error) {
 	if uowFactory == nil {
 		return nil, errs.NewValueIsInvalidError("uowFactory")
 	}
+	if handoverClient == nil {
+		return nil, errs.NewValueIsInvalidError("handoverClient")
+	}
+	if maxDeliveryAttempts < 1 {
+		return nil, errs.NewValueIsInvalidError("maxDeliveryAttempts")
+	}
+	if redeliveryDelay < 0 {
+		return nil, errs.NewValueIsInvalidError("redeliveryDelay")
+	}
 
 	return &moveCouriersHandler{
-		uowFactory: uowFactory,
+		uowFactory:          uowFactory,
+		handoverClient:      handoverClient,
+		maxDeliveryAttempts: maxDeliveryAttempts,
+		redeliveryDelay:     redeliveryDelay,
 	}, nil
 }
 
@@ -31,6 +62,12 @@ func (h *moveCouriersHandler) Handle(ctx context.Context, command MoveCouriersCo
 		return errs.NewValueIsInvalidError("command")
 	}
 
+	return retryOnConflict(ctx, func() error {
+		return h.handle(ctx)
+	})
+}
+
+func (h *moveCouriersHandler) handle(ctx context.Context) error {
 	uow, err := h.uowFactory.New(ctx)
 	if err != nil {
 		return err
@@ -40,35 +77,77 @@ func (h *moveCouriersHandler) Handle(ctx context.Context, command MoveCouriersCo
 	// Start transaction
 	uow.Begin(ctx)
 
-	orders, err := uow.OrderRepository().GetAllInAssignedStatus(ctx)
+	orders, err := uow.OrderRepository().GetAllInDelivery(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, order := range orders {
-		courier, err := uow.CourierRepository().Get(ctx, *order.CourierID())
+	// Every courier moves once per tick along its own route, no matter how many orders it carries
+	ordersByID := make(map[uuid.UUID]*order.Order, len(orders))
+	courierIDs := make([]uuid.UUID, 0, len(orders))
+	for _, o := range orders {
+		ordersByID[o.ID()] = o
+		if !slices.Contains(courierIDs, *o.CourierID()) {
+			courierIDs = append(courierIDs, *o.CourierID())
+		}
+	}
+
+	// Orders which have missed their delivery period are flagged, but still delivered
+	now := time.Now()
+	for _, o := range orders {
+		if o.CheckDeliveryPeriod(now) {
+			err = uow.OrderRepository().Update(ctx, o)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, courierID := range courierIDs {
+		c, err := uow.CourierRepository().Get(ctx, courierID)
 		if err != nil {
 			return err
 		}
-		err = courier.Move(order.Location())
+		if c == nil {
+			return errs.NewObjectNotFoundError("courier", courierID)
+		}
+		reached, err := c.MoveAlongRoute(now)
 		if err != nil {
 			return err
 		}
-		if courier.Location().Equal(order.Location()) {
-			err = order.Complete()
+		for _, stop := range reached {
+			o, ok := ordersByID[stop.OrderID()]
+			if !ok {
+				return errs.NewObjectNotFoundError("order", stop.OrderID())
+			}
+			switch stop.Kind() {
+			case courier.StopKindPickup:
+				err = o.PickUp()
+				if err != nil {
+					return err
+				}
+				err = c.PickUpOrder(o)
+			case courier.StopKindDropOff:
+				err = h.handOver(ctx, c, o, now)
+				if errors.Is(err, errHandoverSkipped) {
+					continue
+				}
+			case courier.StopKindReturn:
+				err = o.ReturnToSender()
+				if err != nil {
+					return err
+				}
+				err = c.CompleteReturn(o)
+			}
 			if err != nil {
 				return err
 			}
-			err = courier.CompleteOrder(order)
+			err = uow.OrderRepository().Update(ctx, o)
 			if err != nil {
 				return err
 			}
 		}
-		err = uow.OrderRepository().Update(ctx, order)
-		if err != nil {
-			return err
-		}
-		err = uow.CourierRepository().Update(ctx, courier)
+		err = uow.CourierRepository().Update(ctx, c)
 		if err != nil {
 			return err
 		}
@@ -81,3 +160,30 @@ func (h *moveCouriersHandler) Handle(ctx context.Context, command MoveCouriersCo
 
 	return nil
 }
+
+// handOver completes the order or, if the recipient did not take it, plans the next attempt
+// or the way back to sender. The parcel stays with the courier until then
+func (h *moveCouriersHandler) handOver(ctx context.Context, c *courier.Courier, o *order.Order, now time.Time) error {
+	reason, err := h.handoverClient.HandOver(ctx, o.ID())
+	if err != nil {
+		// One flaky call should not roll back the moves of all couriers
+		log.Errorf("Failed to hand order %s over: %v", o.ID(), err)
+		return errHandoverSkipped
+	}
+	if reason == order.FailureReasonNone {
+		err = o.Complete()
+		if err != nil {
+			return err
+		}
+		return c.CompleteOrder(o)
+	}
+
+	err = o.FailDelivery(reason)
+	if err != nil {
+		return err
+	}
+	if o.CanBeRedelivered(h.maxDeliveryAttempts) {
+		return c.RetryDelivery(o, now.Add(h.redeliveryDelay))
+	}
+	return c.ReturnToSender(o)
+}
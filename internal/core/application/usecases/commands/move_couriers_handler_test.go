@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var testAddress, _ = kernel.NewAddress("Россия", "Москва", "Тверская", "1", "1")
+
+// fakeHandoverClient answers with the queued results first and hands the parcel over afterwards
+type fakeHandoverClient struct {
+	reasons []order.FailureReason
+	errs    []error
+	calls   int
+}
+
+func (c *fakeHandoverClient) HandOver(context.Context, uuid.UUID) (order.FailureReason, error) {
+	c.calls++
+	var reason order.FailureReason
+	var err error
+	if len(c.reasons) > 0 {
+		reason, c.reasons = c.reasons[0], c.reasons[1:]
+	}
+	if len(c.errs) > 0 {
+		err, c.errs = c.errs[0], c.errs[1:]
+	}
+	return reason, err
+}
+
+// newCourierAtDropOff returns a courier standing at the drop-off with the order picked up
+func newCourierAtDropOff(t *testing.T) (*courier.Courier, *order.Order) {
+	t.Helper()
+	location, _ := kernel.NewLocation(1, 1)
+	c, _ := courier.NewCourier(courier.NameOK, courier.SpeedOK, location)
+	_ = c.StartShift()
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, location, location, kernel.MinVolume, 0, kernel.DeliveryPeriod{})
+	assert.NoError(t, c.TakeOrder(o))
+	assert.NoError(t, o.PickUp())
+	assert.NoError(t, c.PickUpOrder(o))
+	return c, o
+}
+
+func Test_MoveCouriersHandlerWaitsBeforeRedelivery(t *testing.T) {
+	// Arrange
+	c, o := newCourierAtDropOff(t)
+	uow := newFakeUnitOfWork([]*courier.Courier{c}, []*order.Order{o})
+	handoverClient := &fakeHandoverClient{
+		reasons: []order.FailureReason{order.FailureReasonRecipientAbsent, order.FailureReasonRecipientAbsent},
+	}
+	handler, _ := NewMoveCouriersHandler(uow, handoverClient, 3, time.Hour)
+
+	// Act
+	errFirst := handler.Handle(context.Background(), NewMoveCouriersCommand())
+	errSecond := handler.Handle(context.Background(), NewMoveCouriersCommand())
+
+	// Assert
+	assert.NoError(t, errFirst)
+	assert.NoError(t, errSecond)
+	assert.Equal(t, 1, handoverClient.calls, "drop-off should not be visited again before the delay")
+	assert.Equal(t, 1, o.FailedAttempts())
+	assert.Equal(t, order.StatusDeliveryFailed, o.Status())
+}
+
+func Test_MoveCouriersHandlerRedeliversWithoutDelay(t *testing.T) {
+	// Arrange
+	c, o := newCourierAtDropOff(t)
+	uow := newFakeUnitOfWork([]*courier.Courier{c}, []*order.Order{o})
+	handoverClient := &fakeHandoverClient{reasons: []order.FailureReason{order.FailureReasonRecipientAbsent}}
+	handler, _ := NewMoveCouriersHandler(uow, handoverClient, 3, 0)
+
+	// Act
+	errFirst := handler.Handle(context.Background(), NewMoveCouriersCommand())
+	errSecond := handler.Handle(context.Background(), NewMoveCouriersCommand())
+
+	// Assert
+	assert.NoError(t, errFirst)
+	assert.NoError(t, errSecond)
+	assert.Equal(t, 2, handoverClient.calls)
+	assert.Equal(t, order.StatusCompleted, o.Status(), "second attempt should hand the parcel over")
+}
+
+func Test_MoveCouriersHandlerSkipsDropOffWhenHandoverFails(t *testing.T) {
+	// Arrange
+	c, o := newCourierAtDropOff(t)
+	other, _ := courier.NewCourier(courier.NameOK, courier.SpeedOK, kernel.MinLocation())
+	_ = other.StartShift()
+	destination, _ := kernel.NewLocation(5, 5)
+	otherOrder, _ := order.NewOrder(
+		uuid.New(), testAddress, nil, kernel.MinLocation(), destination, kernel.MinVolume, 0, kernel.DeliveryPeriod{},
+	)
+	_ = other.TakeOrder(otherOrder)
+	uow := newFakeUnitOfWork([]*courier.Courier{c, other}, []*order.Order{o, otherOrder})
+	handoverClient := &fakeHandoverClient{errs: []error{errors.New("connection refused")}}
+	handler, _ := NewMoveCouriersHandler(uow, handoverClient, 3, time.Hour)
+
+	// Act
+	errFirst := handler.Handle(context.Background(), NewMoveCouriersCommand())
+	errSecond := handler.Handle(context.Background(), NewMoveCouriersCommand())
+
+	// Assert
+	assert.NoError(t, errFirst, "failed handover should not abort the tick")
+	assert.NoError(t, errSecond)
+	assert.Equal(t, 2, uow.commits)
+	assert.Equal(t, order.StatusPickedUp, otherOrder.Status(), "other courier should keep moving")
+	assert.Zero(t, o.FailedAttempts(), "unanswered handover should not count as an attempt")
+	assert.Equal(t, order.StatusCompleted, o.Status(), "drop-off should be visited again on the next tick")
+}
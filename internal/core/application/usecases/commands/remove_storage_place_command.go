@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type RemoveStoragePlaceCommand struct {
+	courierID      uuid.UUID
+	storagePlaceID uuid.UUID
+
+	isValid bool
+}
+
+func NewRemoveStoragePlaceCommand(courierID uuid.UUID, storagePlaceID uuid.UUID) (RemoveStoragePlaceCommand, error) {
+	if courierID == uuid.Nil {
+		return RemoveStoragePlaceCommand{}, errs.NewValueIsInvalidError("courierID")
+	}
+	if storagePlaceID == uuid.Nil {
+		return RemoveStoragePlaceCommand{}, errs.NewValueIsInvalidError("storagePlaceID")
+	}
+
+	return RemoveStoragePlaceCommand{
+		courierID:      courierID,
+		storagePlaceID: storagePlaceID,
+
+		isValid: true,
+	}, nil
+}
+
+func (c RemoveStoragePlaceCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c RemoveStoragePlaceCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c RemoveStoragePlaceCommand) StoragePlaceID() uuid.UUID {
+	return c.storagePlaceID
+}
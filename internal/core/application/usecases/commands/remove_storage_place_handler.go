@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type RemoveStoragePlaceHandler interface {
+	Handle(context.Context, RemoveStoragePlaceCommand) error
+}
+
+type removeStoragePlaceHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+var _ RemoveStoragePlaceHandler = &removeStoragePlaceHandler{}
+
+func NewRemoveStoragePlaceHandler(uowFactory ports.UnitOfWorkFactory) (RemoveStoragePlaceHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsInvalidError("uowFactory")
+	}
+
+	return &removeStoragePlaceHandler{
+		uowFactory: uowFactory,
+	}, nil
+}
+
+func (h *removeStoragePlaceHandler) Handle(ctx context.Context, command RemoveStoragePlaceCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("command")
+	}
+
+	return retryOnConflict(ctx, func() error {
+		return h.handle(ctx, command)
+	})
+}
+
+func (h *removeStoragePlaceHandler) handle(ctx context.Context, command RemoveStoragePlaceCommand) error {
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	// Start transaction
+	uow.Begin(ctx)
+
+	courierAggregate, err := uow.CourierRepository().Get(ctx, command.CourierID())
+	if err != nil {
+		return err
+	}
+	if courierAggregate == nil {
+		return errs.NewObjectNotFoundError("courier", command.CourierID())
+	}
+
+	err = courierAggregate.RemoveStoragePlace(command.StoragePlaceID())
+	if err != nil {
+		return err
+	}
+
+	err = uow.CourierRepository().Update(ctx, courierAggregate)
+	if err != nil {
+		return err
+	}
+
+	err = uow.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type ResizeStoragePlaceCommand struct {
+	courierID      uuid.UUID
+	storagePlaceID uuid.UUID
+	volume         kernel.Volume
+
+	isValid bool
+}
+
+func NewResizeStoragePlaceCommand(courierID uuid.UUID, storagePlaceID uuid.UUID,
+	volume kernel.Volume) (ResizeStoragePlaceCommand, error) {
+	if courierID == uuid.Nil {
+		return ResizeStoragePlaceCommand{}, errs.NewValueIsInvalidError("courierID")
+	}
+	if storagePlaceID == uuid.Nil {
+		return ResizeStoragePlaceCommand{}, errs.NewValueIsInvalidError("storagePlaceID")
+	}
+	if !volume.IsValid() {
+		return ResizeStoragePlaceCommand{}, errs.NewValueIsInvalidError("volume")
+	}
+
+	return ResizeStoragePlaceCommand{
+		courierID:      courierID,
+		storagePlaceID: storagePlaceID,
+		volume:         volume,
+
+		isValid: true,
+	}, nil
+}
+
+func (c ResizeStoragePlaceCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c ResizeStoragePlaceCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c ResizeStoragePlaceCommand) StoragePlaceID() uuid.UUID {
+	return c.storagePlaceID
+}
+
+func (c ResizeStoragePlaceCommand) Volume() kernel.Volume {
+	return c.volume
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type ResizeStoragePlaceHandler interface {
+	Handle(context.Context, ResizeStoragePlaceCommand) error
+}
+
+type resizeStoragePlaceHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+var _ ResizeStoragePlaceHandler = &resizeStoragePlaceHandler{}
+
+func NewResizeStoragePlaceHandler(uowFactory ports.UnitOfWorkFactory) (ResizeStoragePlaceHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsInvalidError("uowFactory")
+	}
+
+	return &resizeStoragePlaceHandler{
+		uowFactory: uowFactory,
+	}, nil
+}
+
+func (h *resizeStoragePlaceHandler) Handle(ctx context.Context, command ResizeStoragePlaceCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("command")
+	}
+
+	return retryOnConflict(ctx, func() error {
+		return h.handle(ctx, command)
+	})
+}
+
+func (h *resizeStoragePlaceHandler) handle(ctx context.Context, command ResizeStoragePlaceCommand) error {
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	// Start transaction
+	uow.Begin(ctx)
+
+	courierAggregate, err := uow.CourierRepository().Get(ctx, command.CourierID())
+	if err != nil {
+		return err
+	}
+	if courierAggregate == nil {
+		return errs.NewObjectNotFoundError("courier", command.CourierID())
+	}
+
+	err = courierAggregate.ResizeStoragePlace(command.StoragePlaceID(), int(command.Volume()))
+	if err != nil {
+		return err
+	}
+
+	err = uow.CourierRepository().Update(ctx, courierAggregate)
+	if err != nil {
+		return err
+	}
+
+	err = uow.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/pkg/errs"
+	"errors"
+)
+
+// MaxConflictAttempts limits how many times a command is executed when
+// another transaction has changed the same aggregate in between
+const MaxConflictAttempts = 3
+
+// retryOnConflict runs the whole unit of work again with freshly loaded
+// aggregates if it failed on an optimistic lock
+func retryOnConflict(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 0; attempt < MaxConflictAttempts; attempt++ {
+		err = fn()
+		if !errors.Is(err, errs.ErrVersionIsInvalid) {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return err
+}
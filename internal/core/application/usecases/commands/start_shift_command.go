@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type StartShiftCommand struct {
+	courierID uuid.UUID
+
+	isValid bool
+}
+
+func NewStartShiftCommand(courierID uuid.UUID) (StartShiftCommand, error) {
+	if courierID == uuid.Nil {
+		return StartShiftCommand{}, errs.NewValueIsInvalidError("courierID")
+	}
+
+	return StartShiftCommand{
+		courierID: courierID,
+
+		isValid: true,
+	}, nil
+}
+
+func (c StartShiftCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c StartShiftCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
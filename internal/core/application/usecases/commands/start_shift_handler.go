@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type StartShiftHandler interface {
+	Handle(context.Context, StartShiftCommand) error
+}
+
+type startShiftHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+var _ StartShiftHandler = &startShiftHandler{}
+
+func NewStartShiftHandler(uowFactory ports.UnitOfWorkFactory) (StartShiftHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsInvalidError("uowFactory")
+	}
+
+	return &startShiftHandler{
+		uowFactory: uowFactory,
+	}, nil
+}
+
+func (h *startShiftHandler) Handle(ctx context.Context, command StartShiftCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("command")
+	}
+
+	return retryOnConflict(ctx, func() error {
+		return h.handle(ctx, command)
+	})
+}
+
+func (h *startShiftHandler) handle(ctx context.Context, command StartShiftCommand) error {
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	// Start transaction
+	uow.Begin(ctx)
+
+	c, err := uow.CourierRepository().Get(ctx, command.CourierID())
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return errs.NewObjectNotFoundError("courier", command.CourierID())
+	}
+	// Repeated requests are fine, the courier is on shift already
+	if c.Status() == courier.StatusOnShift {
+		return nil
+	}
+
+	err = c.StartShift()
+	if err != nil {
+		return err
+	}
+	err = uow.CourierRepository().Update(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	err = uow.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// UpdateCourierCommand changes only the fields which are set
+type UpdateCourierCommand struct {
+	courierID  uuid.UUID
+	name       *string
+	speed      *int
+	maxPayload *kernel.Weight
+
+	isValid bool
+}
+
+func NewUpdateCourierCommand(
+	courierID uuid.UUID, name *string, speed *int, maxPayload *kernel.Weight,
+) (UpdateCourierCommand, error) {
+	if courierID == uuid.Nil {
+		return UpdateCourierCommand{}, errs.NewValueIsInvalidError("courierID")
+	}
+	if name == nil && speed == nil && maxPayload == nil {
+		return UpdateCourierCommand{}, errs.NewValueIsRequiredError("name, speed or maxPayload")
+	}
+	if name != nil && *name == "" {
+		return UpdateCourierCommand{}, errs.NewValueIsInvalidError("name")
+	}
+	if maxPayload != nil && *maxPayload < 0 {
+		return UpdateCourierCommand{}, errs.NewValueIsInvalidError("maxPayload")
+	}
+
+	return UpdateCourierCommand{
+		courierID:  courierID,
+		name:       name,
+		speed:      speed,
+		maxPayload: maxPayload,
+
+		isValid: true,
+	}, nil
+}
+
+func (c UpdateCourierCommand) IsValid() bool {
+	return c.isValid
+}
+
+func (c UpdateCourierCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c UpdateCourierCommand) Name() (string, bool) {
+	if c.name == nil {
+		return "", false
+	}
+	return *c.name, true
+}
+
+func (c UpdateCourierCommand) Speed() (int, bool) {
+	if c.speed == nil {
+		return 0, false
+	}
+	return *c.speed, true
+}
+
+func (c UpdateCourierCommand) MaxPayload() (kernel.Weight, bool) {
+	if c.maxPayload == nil {
+		return 0, false
+	}
+	return *c.maxPayload, true
+}
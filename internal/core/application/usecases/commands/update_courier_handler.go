@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type UpdateCourierHandler interface {
+	Handle(context.Context, UpdateCourierCommand) error
+}
+
+type updateCourierHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+var _ UpdateCourierHandler = &updateCourierHandler{}
+
+func NewUpdateCourierHandler(uowFactory ports.UnitOfWorkFactory) (UpdateCourierHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsInvalidError("uowFactory")
+	}
+
+	return &updateCourierHandler{
+		uowFactory: uowFactory,
+	}, nil
+}
+
+func (h *updateCourierHandler) Handle(ctx context.Context, command UpdateCourierCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("command")
+	}
+
+	return retryOnConflict(ctx, func() error {
+		return h.handle(ctx, command)
+	})
+}
+
+func (h *updateCourierHandler) handle(ctx context.Context, command UpdateCourierCommand) error {
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	// Start transaction
+	uow.Begin(ctx)
+
+	c, err := uow.CourierRepository().Get(ctx, command.CourierID())
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return errs.NewObjectNotFoundError("courier", command.CourierID())
+	}
+	if name, ok := command.Name(); ok {
+		err = c.Rename(name)
+		if err != nil {
+			return err
+		}
+	}
+	if speed, ok := command.Speed(); ok {
+		err = c.ChangeSpeed(speed)
+		if err != nil {
+			return err
+		}
+	}
+	if maxPayload, ok := command.MaxPayload(); ok {
+		err = c.ChangeMaxPayload(maxPayload)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = uow.CourierRepository().Update(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	err = uow.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package queries
 
 import (
 	"context"
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/pkg/errs"
 
 	"gorm.io/gorm"
@@ -31,7 +32,12 @@ func (h *getAllCouriersHandler) Handle(ctx context.Context, query GetAllCouriers
 	}
 
 	var couriers []CourierResponse
-	res := h.db.Raw("SELECT id, name, location_x, location_y FROM couriers").Scan(&couriers)
+	// Deleted couriers are deactivated, they are kept for order history only
+	res := h.db.Raw(
+		`SELECT id, name, status, transport, location_x, location_y, location_latitude, location_longitude
+		FROM couriers WHERE status <> ?`,
+		courier.StatusDeactivated,
+	).Scan(&couriers)
 	if res.Error != nil {
 		return GetAllCouriersResponse{}, res.Error
 	}
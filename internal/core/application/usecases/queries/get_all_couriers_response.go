@@ -9,12 +9,17 @@ type GetAllCouriersResponse struct {
 type CourierResponse struct {
 	ID uuid.UUID `gorm:"type:uuid;primaryKey"`
 	Name string
+	Status string
+	Transport string
 	Location LocationResponse `gorm:"embedded;embeddedPrefix:location_"`
 }
 
+// LocationResponse has latitude and longitude for geo locations only
 type LocationResponse struct {
-	X int
-	Y int
+	X         int
+	Y         int
+	Latitude  *float64
+	Longitude *float64
 }
 
 func (CourierResponse) TableName() string {
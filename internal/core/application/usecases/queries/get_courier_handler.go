@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"context"
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm"
+)
+
+type GetCourierHandler interface {
+	Handle(context.Context, GetCourierQuery) (GetCourierResponse, error)
+}
+
+type getCourierHandler struct {
+	db *gorm.DB
+}
+
+var _ GetCourierHandler = &getCourierHandler{}
+
+func NewGetCourierHandler(db *gorm.DB) (GetCourierHandler, error) {
+	if db == nil {
+		return nil, errs.NewValueIsInvalidError("gorm DB")
+	}
+
+	return &getCourierHandler{db: db}, nil
+}
+
+func (h *getCourierHandler) Handle(ctx context.Context, query GetCourierQuery) (GetCourierResponse, error) {
+	if !query.IsValid() {
+		return GetCourierResponse{}, errs.NewValueIsInvalidError("query")
+	}
+
+	var courier GetCourierResponse
+	res := h.db.WithContext(ctx).Raw(
+		`SELECT id, name, status, transport, speed, max_payload, location_x, location_y, location_latitude, location_longitude
+		FROM couriers WHERE id = ?`,
+		query.CourierID(),
+	).Scan(&courier)
+	if res.Error != nil {
+		return GetCourierResponse{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return GetCourierResponse{}, errs.NewObjectNotFoundError("courier", query.CourierID())
+	}
+
+	res = h.db.WithContext(ctx).Raw(
+		`SELECT id, name, total_volume, refrigerated, fragile_safe, max_weight, order_id, order_weight
+		FROM storage_places WHERE courier_id = ? ORDER BY name, id`,
+		query.CourierID(),
+	).Scan(&courier.StoragePlaces)
+	if res.Error != nil {
+		return GetCourierResponse{}, res.Error
+	}
+
+	return courier, nil
+}
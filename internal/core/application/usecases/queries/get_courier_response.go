@@ -0,0 +1,41 @@
+package queries
+
+import "github.com/google/uuid"
+
+// GetCourierResponse is the courier with storage places, volumes are in the units of kernel.Volume,
+// weights are in grams
+type GetCourierResponse struct {
+	ID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name string
+	Status string
+	Transport string
+	Speed int
+	MaxPayload int
+	Location LocationResponse `gorm:"embedded;embeddedPrefix:location_"`
+	StoragePlaces []StoragePlaceResponse `gorm:"-"`
+}
+
+type StoragePlaceResponse struct {
+	ID uuid.UUID `gorm:"type:uuid"`
+	Name string
+	TotalVolume int
+	Refrigerated bool
+	FragileSafe bool
+	MaxWeight int
+	OrderID *uuid.UUID `gorm:"type:uuid"`
+	OrderWeight int
+}
+
+// Payload is the total weight of the orders in storage places
+func (r GetCourierResponse) Payload() int {
+	payload := 0
+	for _, place := range r.StoragePlaces {
+		payload += place.OrderWeight
+	}
+	return payload
+}
+
+// IsOccupied tells whether some order is stored in the place
+func (r StoragePlaceResponse) IsOccupied() bool {
+	return r.OrderID != nil
+}
@@ -34,7 +34,10 @@ func (h *getIncompleteOrdersHandler) Handle(ctx context.Context, query GetIncomp
 	var orders []OrderResponse
 
 	res := h.db.Raw(
-		"SELECT id, location_x, location_y FROM orders WHERE status IN (?, ?)", order.StatusCreated, order.StatusAssigned,
+		`SELECT id, location_x, location_y, location_latitude, location_longitude,
+			address_country, address_city, address_street, address_house, address_apartment
+		FROM orders WHERE status IN (?, ?, ?, ?)`,
+		order.StatusCreated, order.StatusAssigned, order.StatusPickedUp, order.StatusDeliveryFailed,
 	).Scan(&orders)
 	if res.Error != nil {
 		return GetIncompleteOrdersResponse{}, res.Error
@@ -9,6 +9,15 @@ type GetIncompleteOrdersResponse struct {
 type OrderResponse struct {
 	ID uuid.UUID `gorm:"type:uuid;primaryKey"`
 	Location LocationResponse `gorm:"embedded;embeddedPrefix:location_"`
+	Address AddressResponse `gorm:"embedded;embeddedPrefix:address_"`
+}
+
+type AddressResponse struct {
+	Country   string
+	City      string
+	Street    string
+	House     string
+	Apartment string
 }
 
 func (OrderResponse) TableName() string {
@@ -0,0 +1,59 @@
+package queries
+
+import (
+	"context"
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm"
+)
+
+type GetOrderHandler interface{
+	Handle(context.Context, GetOrderQuery) (GetOrderResponse, error)
+}
+
+type getOrderHandler struct {
+	db *gorm.DB
+}
+
+var _ GetOrderHandler = &getOrderHandler{}
+
+func NewGetOrderHandler(db *gorm.DB) (GetOrderHandler, error) {
+	if db == nil {
+		return nil, errs.NewValueIsInvalidError("gorm DB")
+	}
+
+	return &getOrderHandler{db: db}, nil
+}
+
+func (h *getOrderHandler) Handle(ctx context.Context, query GetOrderQuery) (GetOrderResponse, error) {
+	if !query.IsValid() {
+		return GetOrderResponse{}, errs.NewValueIsInvalidError("query")
+	}
+
+	var order GetOrderResponse
+	res := h.db.WithContext(ctx).Raw(
+		`SELECT id, status, weight, location_x, location_y, location_latitude, location_longitude,
+			address_country, address_city, address_street, address_house, address_apartment
+		FROM orders WHERE id = ?`,
+		query.OrderID(),
+	).Scan(&order)
+	if res.Error != nil {
+		return GetOrderResponse{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return GetOrderResponse{}, errs.NewObjectNotFoundError("order", query.OrderID())
+	}
+
+	res = h.db.WithContext(ctx).Raw(
+		"SELECT good_id, title, price, quantity FROM order_items WHERE order_id = ? ORDER BY number",
+		query.OrderID(),
+	).Scan(&order.Items)
+	if res.Error != nil {
+		return GetOrderResponse{}, res.Error
+	}
+	for _, item := range order.Items {
+		order.TotalPrice += item.Price * int64(item.Quantity)
+	}
+
+	return order, nil
+}
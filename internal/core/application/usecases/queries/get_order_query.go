@@ -0,0 +1,33 @@
+package queries
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type GetOrderQuery struct {
+	orderID uuid.UUID
+
+	isValid bool
+}
+
+func NewGetOrderQuery(orderID uuid.UUID) (GetOrderQuery, error) {
+	if orderID == uuid.Nil {
+		return GetOrderQuery{}, errs.NewValueIsRequiredError("orderID")
+	}
+
+	return GetOrderQuery{
+		orderID: orderID,
+
+		isValid: true,
+	}, nil
+}
+
+func (q GetOrderQuery) IsValid() bool {
+	return q.isValid
+}
+
+func (q GetOrderQuery) OrderID() uuid.UUID {
+	return q.orderID
+}
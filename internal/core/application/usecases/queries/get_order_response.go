@@ -0,0 +1,21 @@
+package queries
+
+import "github.com/google/uuid"
+
+// GetOrderResponse is the order with its manifest, prices are in minor currency units, weight is in grams
+type GetOrderResponse struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Status     string
+	Weight     int
+	Location   LocationResponse `gorm:"embedded;embeddedPrefix:location_"`
+	Address    AddressResponse  `gorm:"embedded;embeddedPrefix:address_"`
+	Items      []ItemResponse   `gorm:"-"`
+	TotalPrice int64            `gorm:"-"`
+}
+
+type ItemResponse struct {
+	GoodID   uuid.UUID `gorm:"type:uuid"`
+	Title    string
+	Price    int64
+	Quantity int
+}
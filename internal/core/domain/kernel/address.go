@@ -0,0 +1,83 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"strings"
+)
+
+// Address is where the customer wants the order delivered.
+// Street alone is not enough: the same street may exist in several cities
+type Address struct {
+	country   string
+	city      string
+	street    string
+	house     string
+	apartment string
+}
+
+// NewAddress requires city, street and house, country and apartment are optional
+func NewAddress(country, city, street, house, apartment string) (Address, error) {
+	if strings.TrimSpace(city) == "" {
+		return Address{}, errs.NewValueIsRequiredError("city")
+	}
+	if strings.TrimSpace(street) == "" {
+		return Address{}, errs.NewValueIsRequiredError("street")
+	}
+	if strings.TrimSpace(house) == "" {
+		return Address{}, errs.NewValueIsRequiredError("house")
+	}
+	return Address{
+		country:   strings.TrimSpace(country),
+		city:      strings.TrimSpace(city),
+		street:    strings.TrimSpace(street),
+		house:     strings.TrimSpace(house),
+		apartment: strings.TrimSpace(apartment),
+	}, nil
+}
+
+// RestoreAddress for restoring from DB record, so no validation expected.
+// Orders created before addresses were stored have the street only
+func RestoreAddress(country, city, street, house, apartment string) Address {
+	return Address{
+		country:   country,
+		city:      city,
+		street:    street,
+		house:     house,
+		apartment: apartment,
+	}
+}
+
+func (a Address) IsEmpty() bool {
+	return a == Address{}
+}
+
+func (a Address) Country() string {
+	return a.country
+}
+
+func (a Address) City() string {
+	return a.city
+}
+
+func (a Address) Street() string {
+	return a.street
+}
+
+func (a Address) House() string {
+	return a.house
+}
+
+func (a Address) Apartment() string {
+	return a.apartment
+}
+
+// String joins the filled parts from the widest to the narrowest one
+func (a Address) String() string {
+	parts := make([]string, 0, 5)
+	for _, part := range []string{a.country, a.city, a.street, a.house, a.apartment} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
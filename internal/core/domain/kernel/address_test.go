@@ -0,0 +1,42 @@
+package kernel_test
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewAddress(t *testing.T) {
+	a, err := kernel.NewAddress(" Россия ", "Москва", "Тверская", "1", "")
+
+	assert.NoError(t, err, "should be no error creating address without apartment")
+	assert.Equal(t, "Россия", a.Country(), "parts should be trimmed")
+	assert.Equal(t, "Москва", a.City())
+	assert.Equal(t, "Тверская", a.Street())
+	assert.Equal(t, "1", a.House())
+	assert.Empty(t, a.Apartment())
+	assert.Equal(t, "Россия, Москва, Тверская, 1", a.String(), "empty parts should be skipped")
+	assert.False(t, a.IsEmpty())
+}
+
+func Test_NewAddressErrors(t *testing.T) {
+	tests := map[string]struct {
+		city   string
+		street string
+		house  string
+	}{
+		"no_city":   {city: "", street: "Тверская", house: "1"},
+		"no_street": {city: "Москва", street: " ", house: "1"},
+		"no_house":  {city: "Москва", street: "Тверская", house: ""},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a, err := kernel.NewAddress("Россия", test.city, test.street, test.house, "")
+
+			assert.Error(t, err)
+			assert.True(t, a.IsEmpty())
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"time"
+)
+
+const HoursInDay = 24
+
+// DeliveryPeriod is a time window the customer expects the order in.
+// Zero value means the customer accepts any time
+type DeliveryPeriod struct {
+	from time.Time
+	to   time.Time
+}
+
+func NewDeliveryPeriod(from, to time.Time) (DeliveryPeriod, error) {
+	if from.IsZero() {
+		return DeliveryPeriod{}, errs.NewValueIsRequiredError("from")
+	}
+	if !to.After(from) {
+		return DeliveryPeriod{}, errs.NewValueIsInvalidError("to")
+	}
+	return DeliveryPeriod{
+		from: from.UTC(),
+		to:   to.UTC(),
+	}, nil
+}
+
+// NewDeliveryPeriodFromSlot turns a checkout slot in hours of the day, like 9-12,
+// into the nearest window which has not ended yet at the given moment
+func NewDeliveryPeriodFromSlot(fromHour, toHour int, now time.Time) (DeliveryPeriod, error) {
+	if fromHour < 0 || fromHour >= HoursInDay {
+		return DeliveryPeriod{}, errs.NewValueIsOutOfRangeError("fromHour", fromHour, 0, HoursInDay-1)
+	}
+	if toHour <= fromHour || toHour > HoursInDay {
+		return DeliveryPeriod{}, errs.NewValueIsOutOfRangeError("toHour", toHour, fromHour+1, HoursInDay)
+	}
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	to := day.Add(time.Duration(toHour) * time.Hour)
+	if !to.After(now) {
+		day = day.AddDate(0, 0, 1)
+		to = to.AddDate(0, 0, 1)
+	}
+	return NewDeliveryPeriod(day.Add(time.Duration(fromHour)*time.Hour), to)
+}
+
+func (p DeliveryPeriod) IsEmpty() bool {
+	return p.from.IsZero()
+}
+
+func (p DeliveryPeriod) From() time.Time {
+	return p.from
+}
+
+func (p DeliveryPeriod) To() time.Time {
+	return p.to
+}
+
+// IsMissedAt tells whether the window has already ended at the given moment
+func (p DeliveryPeriod) IsMissedAt(moment time.Time) bool {
+	return !p.IsEmpty() && moment.After(p.to)
+}
@@ -0,0 +1,56 @@
+package kernel_test
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewDeliveryPeriodFromSlotToday(t *testing.T) {
+	now := time.Date(2025, 3, 10, 8, 30, 0, 0, time.UTC)
+
+	p, err := kernel.NewDeliveryPeriodFromSlot(9, 12, now)
+
+	assert.NoError(t, err, "should be no error creating period from valid slot")
+	assert.Equal(t, time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC), p.From())
+	assert.Equal(t, time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC), p.To())
+}
+
+func Test_NewDeliveryPeriodFromSlotTomorrowWhenEnded(t *testing.T) {
+	now := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+
+	p, err := kernel.NewDeliveryPeriodFromSlot(9, 12, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 11, 9, 0, 0, 0, time.UTC), p.From(), "ended slot should move to the next day")
+}
+
+func Test_NewDeliveryPeriodFromSlotErrors(t *testing.T) {
+	now := time.Now()
+	tests := map[string]struct {
+		from int
+		to   int
+	}{
+		"negative_from":  {from: -1, to: 5},
+		"empty_slot":     {from: 9, to: 9},
+		"reversed_slot":  {from: 12, to: 9},
+		"after_midnight": {from: 22, to: 25},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := kernel.NewDeliveryPeriodFromSlot(test.from, test.to, now)
+			assert.Error(t, err, "should be error for invalid slot")
+		})
+	}
+}
+
+func Test_DeliveryPeriodIsMissedAt(t *testing.T) {
+	from := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	p, _ := kernel.NewDeliveryPeriod(from, from.Add(3*time.Hour))
+
+	assert.False(t, p.IsMissedAt(from.Add(time.Hour)), "window should not be missed inside it")
+	assert.True(t, p.IsMissedAt(from.Add(4*time.Hour)), "window should be missed after it ends")
+	assert.False(t, kernel.DeliveryPeriod{}.IsMissedAt(from), "empty window can not be missed")
+}
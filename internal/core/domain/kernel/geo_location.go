@@ -0,0 +1,146 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"fmt"
+	"math"
+	"sync/atomic"
+)
+
+const (
+	MinLatitude  = -90.0
+	MaxLatitude  = 90.0
+	MinLongitude = -180.0
+	MaxLongitude = 180.0
+
+	// EarthRadiusKm is the mean radius of the WGS84 ellipsoid
+	EarthRadiusKm = 6371.0088
+)
+
+// NewGeoLocation is a WGS84 point, distances between such points are in kilometres
+func NewGeoLocation(latitude, longitude float64) (Location, error) {
+	if math.IsNaN(latitude) || latitude < MinLatitude || latitude > MaxLatitude {
+		return Location{}, errs.NewValueIsOutOfRangeError("latitude", latitude, MinLatitude, MaxLatitude)
+	}
+	if math.IsNaN(longitude) || longitude < MinLongitude || longitude > MaxLongitude {
+		return Location{}, errs.NewValueIsOutOfRangeError("longitude", longitude, MinLongitude, MaxLongitude)
+	}
+	loc := Location{
+		latitude:  latitude,
+		longitude: longitude,
+		geo:       true,
+		valid:     true,
+	}
+	return loc, nil
+}
+
+// GeoArea is the part of the map where geo locations are made up, when nothing better is known
+type GeoArea struct {
+	south float64
+	west  float64
+	north float64
+	east  float64
+}
+
+func NewGeoArea(south, west, north, east float64) (GeoArea, error) {
+	if _, err := NewGeoLocation(south, west); err != nil {
+		return GeoArea{}, err
+	}
+	if _, err := NewGeoLocation(north, east); err != nil {
+		return GeoArea{}, err
+	}
+	if south >= north {
+		return GeoArea{}, errs.NewValueIsInvalidError("south")
+	}
+	if west >= east {
+		return GeoArea{}, errs.NewValueIsInvalidError("west")
+	}
+	return GeoArea{south: south, west: west, north: north, east: east}, nil
+}
+
+// DefaultGeoArea is Moscow within the MKAD ring road
+func DefaultGeoArea() GeoArea {
+	return GeoArea{south: 55.57, west: 37.37, north: 55.91, east: 37.84}
+}
+
+func (a GeoArea) SouthWest() Location {
+	loc, _ := NewGeoLocation(a.south, a.west)
+	return loc
+}
+
+func (a GeoArea) NorthEast() Location {
+	loc, _ := NewGeoLocation(a.north, a.east)
+	return loc
+}
+
+var currentGeoArea atomic.Pointer[GeoArea]
+
+// UseGeoCoordinates switches the app to lat/lon locations at startup,
+// min, max and random locations are taken from the area from then on
+func UseGeoCoordinates(area GeoArea) {
+	currentGeoArea.Store(&area)
+}
+
+// UseGridCoordinates switches the app back to the city grid
+func UseGridCoordinates() {
+	currentGeoArea.Store(nil)
+}
+
+func GeoCoordinatesEnabled() bool {
+	return currentGeoArea.Load() != nil
+}
+
+func CurrentGeoArea() GeoArea {
+	if area := currentGeoArea.Load(); area != nil {
+		return *area
+	}
+	return DefaultGeoArea()
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
+
+// centralAngle is the angle between two points seen from the centre of the Earth, by the haversine formula
+func centralAngle(from, to Location) float64 {
+	lat1, lat2 := toRadians(from.latitude), toRadians(to.latitude)
+	dLat := lat2 - lat1
+	dLon := toRadians(to.longitude - from.longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// StepTowards moves the geo location along the great circle to the target by the given kilometres,
+// the target itself is returned when it is closer than that
+func (l Location) StepTowards(target Location, km float64) (Location, error) {
+	if !l.IsValid() || !l.IsGeo() {
+		return Location{}, fmt.Errorf("location: %v is not a valid geo location", l)
+	}
+	if !target.IsValid() || !target.IsGeo() {
+		return Location{}, fmt.Errorf("target location: %v is not a valid geo location", target)
+	}
+	if km < 0 {
+		return Location{}, errs.NewValueIsInvalidError("km")
+	}
+	angle := centralAngle(l, target)
+	if angle*EarthRadiusKm <= km {
+		return target, nil
+	}
+
+	// Spherical interpolation between the two points
+	fraction := km / (angle * EarthRadiusKm)
+	a := math.Sin((1-fraction)*angle) / math.Sin(angle)
+	b := math.Sin(fraction*angle) / math.Sin(angle)
+	lat1, lon1 := toRadians(l.latitude), toRadians(l.longitude)
+	lat2, lon2 := toRadians(target.latitude), toRadians(target.longitude)
+	x := a*math.Cos(lat1)*math.Cos(lon1) + b*math.Cos(lat2)*math.Cos(lon2)
+	y := a*math.Cos(lat1)*math.Sin(lon1) + b*math.Cos(lat2)*math.Sin(lon2)
+	z := a*math.Sin(lat1) + b*math.Sin(lat2)
+	latitude := toDegrees(math.Atan2(z, math.Sqrt(x*x+y*y)))
+	longitude := toDegrees(math.Atan2(y, x))
+	return NewGeoLocation(latitude, longitude)
+}
@@ -0,0 +1,77 @@
+package kernel_test
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewGeoLocationWithOutOfRangeReturnError(t *testing.T) {
+	_, err := kernel.NewGeoLocation(91, 37.6)
+	assert.Error(t, err, "latitude beyond the pole should be rejected")
+	_, err = kernel.NewGeoLocation(55.7, -181)
+	assert.Error(t, err, "longitude beyond the antimeridian should be rejected")
+}
+
+func Test_GeoLocationsHaversineDistance(t *testing.T) {
+	// Arrange
+	moscow, _ := kernel.NewGeoLocation(55.7558, 37.6173)
+	petersburg, _ := kernel.NewGeoLocation(59.9343, 30.3351)
+
+	// Act
+	d1, err1 := moscow.Distance(petersburg)
+	d2, err2 := petersburg.Distance(moscow)
+
+	// Assert
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.InDelta(t, 634, d1, 2, "distance between Moscow and Saint Petersburg should be about 634 km")
+	assert.InDelta(t, d1, d2, 1e-9)
+}
+
+func Test_GeoAndGridLocationsDistanceReturnError(t *testing.T) {
+	point, _ := kernel.NewGeoLocation(55.7558, 37.6173)
+
+	_, err := point.Distance(kernel.MinLocation())
+
+	assert.Error(t, err, "grid cell and geo point are not comparable")
+}
+
+func Test_GeoLocationStepTowards(t *testing.T) {
+	// Arrange
+	from, _ := kernel.NewGeoLocation(55.0, 37.0)
+	target, _ := kernel.NewGeoLocation(56.0, 38.0)
+	total, _ := from.Distance(target)
+
+	// Act
+	step, err := from.StepTowards(target, 10)
+	last, errLast := step.StepTowards(target, total)
+
+	// Assert
+	assert.NoError(t, err)
+	travelled, _ := from.Distance(step)
+	left, _ := step.Distance(target)
+	assert.InDelta(t, 10, travelled, 1e-6, "step should be as long as asked")
+	assert.InDelta(t, total-10, left, 1e-6, "step should go along the great circle")
+	assert.NoError(t, errLast)
+	assert.True(t, last.Equal(target), "step longer than the rest of the way should end at the target")
+}
+
+func Test_GeoModeBoundsRandomLocations(t *testing.T) {
+	// Arrange
+	area, err := kernel.NewGeoArea(55.5, 37.3, 56.0, 37.9)
+	assert.NoError(t, err)
+	kernel.UseGeoCoordinates(area)
+	t.Cleanup(kernel.UseGridCoordinates)
+
+	// Act
+	loc, err := kernel.RandomLocation()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, loc.IsGeo(), "random location should be a geo point in geo mode")
+	assert.True(t, loc.Latitude() >= 55.5 && loc.Latitude() <= 56.0)
+	assert.True(t, loc.Longitude() >= 37.3 && loc.Longitude() <= 37.9)
+	assert.True(t, kernel.MinLocation().Equal(area.SouthWest()))
+}
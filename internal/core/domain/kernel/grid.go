@@ -0,0 +1,65 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"sync/atomic"
+)
+
+const (
+	MinX = 1
+	MinY = 1
+
+	DefaultMaxX = 10
+	DefaultMaxY = 10
+)
+
+// Grid is the bounds of the city map, cells are numbered from MinX, MinY to MaxX, MaxY
+type Grid struct {
+	maxX int
+	maxY int
+}
+
+func NewGrid(maxX, maxY int) (Grid, error) {
+	if maxX < MinX {
+		return Grid{}, errs.NewValueIsInvalidError("maxX")
+	}
+	if maxY < MinY {
+		return Grid{}, errs.NewValueIsInvalidError("maxY")
+	}
+	return Grid{maxX: maxX, maxY: maxY}, nil
+}
+
+func DefaultGrid() Grid {
+	return Grid{maxX: DefaultMaxX, maxY: DefaultMaxY}
+}
+
+var currentGrid atomic.Pointer[Grid]
+
+// SetGrid configures the city map at startup, locations are validated against it from then on
+func SetGrid(grid Grid) {
+	currentGrid.Store(&grid)
+}
+
+func CurrentGrid() Grid {
+	if grid := currentGrid.Load(); grid != nil {
+		return *grid
+	}
+	return DefaultGrid()
+}
+
+func (g Grid) MaxX() int {
+	return g.maxX
+}
+
+func (g Grid) MaxY() int {
+	return g.maxY
+}
+
+func (g Grid) Contains(x, y int) bool {
+	return x >= MinX && x <= g.maxX && y >= MinY && y <= g.maxY
+}
+
+// Clamp moves the point to the nearest cell of the grid
+func (g Grid) Clamp(x, y int) (int, int) {
+	return min(max(x, MinX), g.maxX), min(max(y, MinY), g.maxY)
+}
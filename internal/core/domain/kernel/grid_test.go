@@ -0,0 +1,42 @@
+package kernel_test
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewGridWithWrongBoundsReturnError(t *testing.T) {
+	_, err := kernel.NewGrid(0, 10)
+	assert.Error(t, err, "grid without columns should be rejected")
+	_, err = kernel.NewGrid(10, 0)
+	assert.Error(t, err, "grid without rows should be rejected")
+}
+
+func Test_ConfiguredGridBoundsLocations(t *testing.T) {
+	// Arrange
+	grid, err := kernel.NewGrid(100, 50)
+	assert.NoError(t, err)
+	kernel.SetGrid(grid)
+	t.Cleanup(func() { kernel.SetGrid(kernel.DefaultGrid()) })
+
+	// Act
+	inside, errInside := kernel.NewLocation(100, 50)
+	_, errOutside := kernel.NewLocation(100, 51)
+
+	// Assert
+	assert.NoError(t, errInside, "location on the grid edge should be valid")
+	assert.Equal(t, 100, inside.X())
+	assert.Error(t, errOutside, "location beyond the grid should be rejected")
+	assert.Equal(t, 50, kernel.MaxLocation().Y(), "max location should follow the grid")
+}
+
+func Test_GridClamp(t *testing.T) {
+	grid, _ := kernel.NewGrid(20, 30)
+
+	x, y := grid.Clamp(-5, 42)
+
+	assert.Equal(t, kernel.MinX, x)
+	assert.Equal(t, 30, y)
+}
@@ -8,19 +8,14 @@ import (
 	"delivery/internal/pkg/errs"
 )
 
-const (
-	MinX = 1
-	MaxX = 10
-	MinY = 1
-	MaxY = 10
-)
-
-func NewLocation(x, y uint8) (Location, error) {
-	if x < MinX || x > MaxX {
-		return Location{}, errs.NewValueIsOutOfRangeError("x", x, MinX, MaxX)
+// NewLocation checks the point against the configured grid
+func NewLocation(x, y int) (Location, error) {
+	grid := CurrentGrid()
+	if x < MinX || x > grid.MaxX() {
+		return Location{}, errs.NewValueIsOutOfRangeError("x", x, MinX, grid.MaxX())
 	}
-	if y < MinY || y > MaxY {
-		return Location{}, errs.NewValueIsOutOfRangeError("y", y, MinY, MaxY)
+	if y < MinY || y > grid.MaxY() {
+		return Location{}, errs.NewValueIsOutOfRangeError("y", y, MinY, grid.MaxY())
 	}
 	loc := Location{
 		x:     x,
@@ -31,40 +26,70 @@ func NewLocation(x, y uint8) (Location, error) {
 }
 
 func MinLocation() Location {
+	if GeoCoordinatesEnabled() {
+		return CurrentGeoArea().SouthWest()
+	}
 	loc, _ := NewLocation(MinX, MinY)
 	return loc
 }
 
 func MaxLocation() Location {
-	loc, _ := NewLocation(MaxX, MaxY)
+	if GeoCoordinatesEnabled() {
+		return CurrentGeoArea().NorthEast()
+	}
+	grid := CurrentGrid()
+	loc, _ := NewLocation(grid.MaxX(), grid.MaxY())
 	return loc
 }
 
 func RandomLocation() (Location, error) {
 	randomizer := rand.New(rand.NewSource(time.Now().Unix()))
-	randx := randomizer.Intn(MaxX - MinX + 1) + MinX
-	randy := randomizer.Intn(MaxY - MinY + 1) + MinY
-	loc, err := NewLocation(uint8(randx), uint8(randy))
+	if GeoCoordinatesEnabled() {
+		area := CurrentGeoArea()
+		latitude := area.south + randomizer.Float64()*(area.north-area.south)
+		longitude := area.west + randomizer.Float64()*(area.east-area.west)
+		return NewGeoLocation(latitude, longitude)
+	}
+	grid := CurrentGrid()
+	randx := randomizer.Intn(grid.MaxX() - MinX + 1) + MinX
+	randy := randomizer.Intn(grid.MaxY() - MinY + 1) + MinY
+	loc, err := NewLocation(randx, randy)
 	if err != nil {
 		return Location{}, err
 	}
 	return loc, nil
 }
 
+// Location is either a cell of the city grid or a lat/lon point
 type Location struct {
-	x     uint8
-	y     uint8
-	valid bool
+	x         int
+	y         int
+	latitude  float64
+	longitude float64
+	geo       bool
+	valid     bool
 }
 
-func (l Location) X() uint8 {
+func (l Location) X() int {
 	return l.x
 }
 
-func (l Location) Y() uint8 {
+func (l Location) Y() int {
 	return l.y
 }
 
+func (l Location) Latitude() float64 {
+	return l.latitude
+}
+
+func (l Location) Longitude() float64 {
+	return l.longitude
+}
+
+func (l Location) IsGeo() bool {
+	return l.geo
+}
+
 func (l Location) Equal(target Location) bool {
 	return l == target
 }
@@ -80,11 +105,18 @@ func abs(a int) int {
 	return a
 }
 
-func (l Location) Distance(target Location) (uint8, error) {
+// Distance is Manhattan distance in cells on the grid and haversine distance in kilometres for geo locations
+func (l Location) Distance(target Location) (float64, error) {
 	if !target.IsValid() {
 		return 0, fmt.Errorf("target location: %v is not valid", target)
 	}
-	distX := abs(int(l.X()) - int(target.X()))
-	distY := abs(int(l.Y()) - int(target.Y()))
-	return uint8(distX) + uint8(distY), nil
+	if l.IsGeo() != target.IsGeo() {
+		return 0, fmt.Errorf("target location: %v is not in the same coordinate system", target)
+	}
+	if l.IsGeo() {
+		return centralAngle(l, target) * EarthRadiusKm, nil
+	}
+	distX := abs(l.X() - target.X())
+	distY := abs(l.Y() - target.Y())
+	return float64(distX + distY), nil
 }
@@ -19,26 +19,26 @@ func Test_NewLocCorrectParams(t *testing.T) {
 	assert.NotEmpty(t, loc, "new location should not be empty")
 	assert.NoError(t, err, "should not be error creating new location")
 	assert.True(t, loc.IsValid(), "new location should be valid")
-	assert.Equal(t, uint8(3), loc.X(), "location X shall be 3")
-	assert.Equal(t, uint8(4), loc.Y(), "location Y shall be 4")
+	assert.Equal(t, 3, loc.X(), "location X shall be 3")
+	assert.Equal(t, 4, loc.Y(), "location Y shall be 4")
 }
 
 func Test_NewLocWithOutOfRangeReturnError(t *testing.T) {
 	// Arrange
 	tests := map[string]struct {
-		x        uint8
-		y        uint8
+		x        int
+		y        int
 		expected error
 	}{
 		"wrong_x": {
-			x: uint8(15),
-			y: uint8(4),
-			expected: errs.NewValueIsOutOfRangeError("x", uint8(15), kernel.MinX, kernel.MaxX),
+			x: 15,
+			y: 4,
+			expected: errs.NewValueIsOutOfRangeError("x", 15, kernel.MinX, kernel.DefaultMaxX),
 		},
 		"wrong_y": {
-			x: uint8(3),
-			y: uint8(0),
-			expected: errs.NewValueIsOutOfRangeError("y", uint8(0), kernel.MinY, kernel.MaxY),
+			x: 3,
+			y: 0,
+			expected: errs.NewValueIsOutOfRangeError("y", 0, kernel.MinY, kernel.DefaultMaxY),
 		},
 	}
 
@@ -72,8 +72,8 @@ func Test_MinLocation(t *testing.T) {
 
 	// Assert
 	assert.NotEmpty(t, loc, "new location should not be empty")
-	assert.Equal(t, loc.X(), uint8(kernel.MinX))
-	assert.Equal(t, loc.Y(), uint8(kernel.MinY))
+	assert.Equal(t, loc.X(), kernel.MinX)
+	assert.Equal(t, loc.Y(), kernel.MinY)
 }
 
 func Test_MaxLocation(t *testing.T) {
@@ -83,8 +83,8 @@ func Test_MaxLocation(t *testing.T) {
 
 	// Assert
 	assert.NotEmpty(t, loc, "new location should not be empty")
-	assert.Equal(t, loc.X(), uint8(kernel.MaxX))
-	assert.Equal(t, loc.Y(), uint8(kernel.MaxY))
+	assert.Equal(t, loc.X(), kernel.DefaultMaxX)
+	assert.Equal(t, loc.Y(), kernel.DefaultMaxY)
 }
 
 func Test_LocationsEqual(t *testing.T) {
@@ -116,8 +116,8 @@ func Test_MinMaxLocationsDistance(t *testing.T) {
 	d2, err2 := loc2.Distance(loc1)
 	
 	// Assert
-	assert.Equal(t, uint8(d1), uint8(18), "distance between min and max should be 18")
+	assert.Equal(t, d1, 18.0, "distance between min and max should be 18")
 	assert.NoError(t, err1)
 	assert.NoError(t, err2)
-	assert.Equal(t, uint8(d1), uint8(d2), fmt.Sprintf("expected equal, got: %d != %d", d1, d2))
+	assert.Equal(t, d1, d2, fmt.Sprintf("expected equal, got: %v != %v", d1, d2))
 }
@@ -0,0 +1,26 @@
+package kernel
+
+import "delivery/internal/pkg/errs"
+
+// Weight is in grams, zero means the weight is not known or not limited
+type Weight int
+
+func NewWeight(grams int) (Weight, error) {
+	if grams < 0 {
+		return 0, errs.NewValueIsInvalidError("weight")
+	}
+	return Weight(grams), nil
+}
+
+func (w Weight) Grams() int {
+	return int(w)
+}
+
+func (w Weight) IsZero() bool {
+	return w == 0
+}
+
+// FitsTo treats zero limit as no limit
+func (w Weight) FitsTo(limit Weight) bool {
+	return limit.IsZero() || w <= limit
+}
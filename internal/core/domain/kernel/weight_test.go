@@ -0,0 +1,21 @@
+package kernel_test
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewWeightWithNegativeGramsReturnError(t *testing.T) {
+	_, err := kernel.NewWeight(-1)
+	assert.Error(t, err, "negative weight should be rejected")
+}
+
+func Test_WeightFitsTo(t *testing.T) {
+	weight, _ := kernel.NewWeight(5000)
+
+	assert.True(t, weight.FitsTo(5000), "weight equal to the limit should fit")
+	assert.False(t, weight.FitsTo(4999), "weight over the limit should not fit")
+	assert.True(t, weight.FitsTo(0), "zero limit means no limit")
+}
@@ -0,0 +1,51 @@
+package kernel
+
+import "math"
+
+const (
+	ZoneEmpty   Zone = ""
+	ZoneCenter  Zone = "Center"
+	ZoneCity    Zone = "City"
+	ZoneSuburbs Zone = "Suburbs"
+)
+
+// Zone is a ring of the city map: the center is the inner third, the suburbs are the outer third
+type Zone string
+
+func (z Zone) String() string {
+	return string(z)
+}
+
+// ZoneOf places the location on the current grid or geo area
+func ZoneOf(location Location) Zone {
+	if !location.IsValid() {
+		return ZoneEmpty
+	}
+	var dx, dy float64
+	if location.IsGeo() {
+		area := CurrentGeoArea()
+		dx = fromCenter(location.Longitude(), area.west, area.east)
+		dy = fromCenter(location.Latitude(), area.south, area.north)
+	} else {
+		grid := CurrentGrid()
+		dx = fromCenter(float64(location.X()), MinX, float64(grid.MaxX()))
+		dy = fromCenter(float64(location.Y()), MinY, float64(grid.MaxY()))
+	}
+	switch ring := math.Max(dx, dy); {
+	case ring < 1.0/3:
+		return ZoneCenter
+	case ring < 2.0/3:
+		return ZoneCity
+	default:
+		return ZoneSuburbs
+	}
+}
+
+// fromCenter is 0 in the middle of the range and 1 on its edges
+func fromCenter(value, low, high float64) float64 {
+	if high <= low {
+		return 0
+	}
+	middle := (low + high) / 2
+	return math.Abs(value-middle) / (middle - low)
+}
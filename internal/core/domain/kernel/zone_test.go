@@ -0,0 +1,30 @@
+package kernel_test
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ZoneOfGridLocation(t *testing.T) {
+	center, _ := kernel.NewLocation(5, 6)
+	city, _ := kernel.NewLocation(3, 5)
+	suburbs, _ := kernel.NewLocation(5, 10)
+
+	assert.Equal(t, kernel.ZoneCenter, kernel.ZoneOf(center))
+	assert.Equal(t, kernel.ZoneCity, kernel.ZoneOf(city))
+	assert.Equal(t, kernel.ZoneSuburbs, kernel.ZoneOf(suburbs))
+	assert.Equal(t, kernel.ZoneSuburbs, kernel.ZoneOf(kernel.MinLocation()))
+	assert.Equal(t, kernel.ZoneEmpty, kernel.ZoneOf(kernel.Location{}))
+}
+
+func Test_ZoneOfGeoLocation(t *testing.T) {
+	kernel.UseGeoCoordinates(kernel.DefaultGeoArea())
+	defer kernel.UseGridCoordinates()
+	kremlin, _ := kernel.NewGeoLocation(55.75, 37.62)
+	butovo, _ := kernel.NewGeoLocation(55.58, 37.55)
+
+	assert.Equal(t, kernel.ZoneCenter, kernel.ZoneOf(kremlin))
+	assert.Equal(t, kernel.ZoneSuburbs, kernel.ZoneOf(butovo))
+}
@@ -3,9 +3,12 @@ package courier
 import (
 	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
 	"errors"
 	"math"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -13,6 +16,11 @@ import (
 var (
 	ErrCourierCanNotTakeOrder = errors.New("this Courier can not take this order")
 	ErrNoOrderFound           = errors.New("this Courier doesn't carry such order")
+	ErrCourierStatusIsWrong   = errors.New("wrong courier status for the action")
+	ErrCourierCarriesOrders   = errors.New("this Courier still carries orders")
+	ErrStoragePlaceNotFound   = errors.New("this Courier doesn't have such storage place")
+	ErrLastStoragePlace       = errors.New("the last storage place of a Courier can not be removed")
+	ErrCourierIsOverloaded    = errors.New("this Courier can not carry more weight")
 )
 
 const (
@@ -22,15 +30,41 @@ const (
 	NameOK   = "SomeName"
 )
 
+var _ ddd.AggregateRoot = &Courier{}
+
 type Courier struct {
-	id            uuid.UUID
+	baseAggregate *ddd.BaseAggregate[uuid.UUID]
 	name          string
 	location      kernel.Location
 	speed         int
+	transport     Transport
+	maxPayload    kernel.Weight
+	status        Status
 	storagePlaces []*StoragePlace
+	stops         []Stop
 }
 
+// NewCourier creates a courier with a bag and no payload limit, it goes anywhere like a car
 func NewCourier(name string, speed int, location kernel.Location) (*Courier, error) {
+	return newCourier(name, TransportCar, speed, location, []*StoragePlace{NewBag()}, 0)
+}
+
+// NewCourierOnTransport takes the storage places and the payload limit from the transport profile,
+// zero speed means the default speed of the transport
+func NewCourierOnTransport(name string, transport Transport, speed int, location kernel.Location) (*Courier, error) {
+	if !transport.IsValid() {
+		return nil, errs.NewValueIsInvalidError("transport")
+	}
+	if speed == 0 {
+		speed = transport.DefaultSpeed()
+	}
+	return newCourier(name, transport, speed, location, transport.DefaultStoragePlaces(), transport.DefaultMaxPayload())
+}
+
+func newCourier(
+	name string, transport Transport, speed int, location kernel.Location, storagePlaces []*StoragePlace,
+	maxPayload kernel.Weight,
+) (*Courier, error) {
 	if name == "" {
 		return nil, errs.NewValueIsInvalidError("name")
 	}
@@ -40,32 +74,44 @@ func NewCourier(name string, speed int, location kernel.Location) (*Courier, err
 	if speed < MinSpeed || speed > MaxSpeed {
 		return nil, errs.NewValueIsOutOfRangeError("speed", speed, MinSpeed, MaxSpeed)
 	}
+	c := &Courier{
+		baseAggregate: ddd.NewBaseAggregate(uuid.New()),
+		name:          name,
+		speed:         speed,
+		transport:     transport,
+		maxPayload:    maxPayload,
+		location:      location,
+		status:        StatusOffDuty,
+		storagePlaces: storagePlaces,
+	}
+	c.RaiseDomainEvent(NewCourierCreatedDomainEvent(c))
+	return c, nil
+}
+
+// RestoreCourier creates from DB record, so no error is expected here.
+// Stops must be passed in the order they are visited
+func RestoreCourier(
+	name string, speed int, transport Transport, location kernel.Location, id uuid.UUID, status Status,
+	maxPayload kernel.Weight, storagePlaces []*StoragePlace, stops []Stop, version int64,
+) *Courier {
 	return &Courier{
-		id:       uuid.New(),
-		name:     name,
-		speed:    speed,
-		location: location,
-		storagePlaces: []*StoragePlace{
-			NewBag(),
-		},
-	}, nil
-}
-
-// RestoreCourier creates from DB record, so no error is expected here
-func RestoreCourier(name string, speed int, location kernel.Location, id uuid.UUID, storagePlaces []*StoragePlace) *Courier {
-	return &Courier{
-		id:            id,
+		baseAggregate: ddd.RestoreBaseAggregate(id, version),
 		name:          name,
 		speed:         speed,
+		transport:     transport,
 		location:      location,
+		maxPayload:    maxPayload,
+		status:        status,
 		storagePlaces: storagePlaces,
+		stops:         stops,
 	}
 }
 
-// CreateCourierOK may be used for test as normal courier object w/o errors
+// CreateCourierOK may be used for test as normal courier object w/o errors, it is on shift already
 func CreateCourierOK() *Courier {
 	location, _ := kernel.RandomLocation()
 	c, _ := NewCourier(NameOK, SpeedOK, location)
+	_ = c.StartShift()
 	return c
 }
 
@@ -73,11 +119,19 @@ func (c *Courier) Equal(target *Courier) bool {
 	if target == nil {
 		return false
 	}
-	return c.id == target.id
+	return c.baseAggregate.Equal(target.baseAggregate)
 }
 
 func (c *Courier) ID() uuid.UUID {
-	return c.id
+	return c.baseAggregate.ID()
+}
+
+func (c *Courier) Version() int64 {
+	return c.baseAggregate.Version()
+}
+
+func (c *Courier) SetVersion(version int64) {
+	c.baseAggregate.SetVersion(version)
 }
 
 func (c *Courier) Location() kernel.Location {
@@ -92,29 +146,254 @@ func (c *Courier) Speed() int {
 	return c.speed
 }
 
+func (c *Courier) Transport() Transport {
+	return c.transport
+}
+
+// MaxPayload is the total weight the courier can carry, zero means no limit
+func (c *Courier) MaxPayload() kernel.Weight {
+	return c.maxPayload
+}
+
+// Payload is the total weight of the carried orders
+func (c *Courier) Payload() kernel.Weight {
+	var payload kernel.Weight
+	for _, place := range c.storagePlaces {
+		payload += place.OrderWeight()
+	}
+	return payload
+}
+
+func (c *Courier) Status() Status {
+	return c.status
+}
+
+// IsOnShift tells whether the courier may get new orders
+func (c *Courier) IsOnShift() bool {
+	return c.status == StatusOnShift
+}
+
+// CarriesOrders tells whether some order is still in a storage place or on the route
+func (c *Courier) CarriesOrders() bool {
+	if len(c.stops) > 0 {
+		return true
+	}
+	for _, place := range c.storagePlaces {
+		if place.IsOccupied() {
+			return true
+		}
+	}
+	return false
+}
+
+// StartShift puts a courier who is off duty to work
+func (c *Courier) StartShift() error {
+	if c.status != StatusOffDuty {
+		return ErrCourierStatusIsWrong
+	}
+	c.changeStatus(StatusOnShift)
+	return nil
+}
+
+// TakeBreak stops new orders for a while, the orders already taken are carried on
+func (c *Courier) TakeBreak() error {
+	if c.status != StatusOnShift {
+		return ErrCourierStatusIsWrong
+	}
+	c.changeStatus(StatusOnBreak)
+	return nil
+}
+
+func (c *Courier) EndBreak() error {
+	if c.status != StatusOnBreak {
+		return ErrCourierStatusIsWrong
+	}
+	c.changeStatus(StatusOnShift)
+	return nil
+}
+
+// EndShift is refused until every taken order is delivered or returned
+func (c *Courier) EndShift() error {
+	if c.status != StatusOnShift && c.status != StatusOnBreak {
+		return ErrCourierStatusIsWrong
+	}
+	if c.CarriesOrders() {
+		return ErrCourierCarriesOrders
+	}
+	c.changeStatus(StatusOffDuty)
+	return nil
+}
+
+// Deactivate is final, only a courier who is off duty may be deactivated
+func (c *Courier) Deactivate() error {
+	if c.status != StatusOffDuty {
+		return ErrCourierStatusIsWrong
+	}
+	c.changeStatus(StatusDeactivated)
+	return nil
+}
+
+func (c *Courier) changeStatus(status Status) {
+	c.status = status
+	c.RaiseDomainEvent(NewCourierStatusChangedDomainEvent(c))
+}
+
 func (c *Courier) StoragePlaces() []*StoragePlace {
 	return c.storagePlaces
 }
 
+// Stops returns the planned route in the order it is visited
+func (c *Courier) Stops() []Stop {
+	stops := make([]Stop, len(c.stops))
+	copy(stops, c.stops)
+	return stops
+}
+
+// NextStop returns the stop the courier is heading to, or false if the route is empty
+func (c *Courier) NextStop() (Stop, bool) {
+	if len(c.stops) == 0 {
+		return Stop{}, false
+	}
+	return c.stops[0], true
+}
+
+func (c *Courier) GetDomainEvents() []ddd.DomainEvent {
+	return c.baseAggregate.GetDomainEvents()
+}
+
+func (c *Courier) ClearDomainEvents() {
+	c.baseAggregate.ClearDomainEvents()
+}
+
+func (c *Courier) RaiseDomainEvent(event ddd.DomainEvent) {
+	c.baseAggregate.RaiseDomainEvent(event)
+}
+
+// Rename and ChangeSpeed are not possible for a deactivated courier, as well as new storage places
+func (c *Courier) Rename(name string) error {
+	if c.status == StatusDeactivated {
+		return ErrCourierStatusIsWrong
+	}
+	if name == "" {
+		return errs.NewValueIsInvalidError("name")
+	}
+	c.name = name
+	return nil
+}
+
+func (c *Courier) ChangeSpeed(speed int) error {
+	if c.status == StatusDeactivated {
+		return ErrCourierStatusIsWrong
+	}
+	if speed < MinSpeed || speed > MaxSpeed {
+		return errs.NewValueIsOutOfRangeError("speed", speed, MinSpeed, MaxSpeed)
+	}
+	c.speed = speed
+	return nil
+}
+
+// ChangeMaxPayload does not unload the courier, the limit applies to the next orders
+func (c *Courier) ChangeMaxPayload(maxPayload kernel.Weight) error {
+	if c.status == StatusDeactivated {
+		return ErrCourierStatusIsWrong
+	}
+	if maxPayload < 0 {
+		return errs.NewValueIsInvalidError("maxPayload")
+	}
+	c.maxPayload = maxPayload
+	return nil
+}
+
 func (c *Courier) AddStoragePlace(name string, volume int) error {
+	return c.AddEquippedStoragePlace(name, volume, Equipment{})
+}
+
+func (c *Courier) AddEquippedStoragePlace(name string, volume int, equipment Equipment) error {
+	if c.status == StatusDeactivated {
+		return ErrCourierStatusIsWrong
+	}
 	v, err := kernel.NewVolume(volume)
 	if err != nil {
 		return err
 	}
-	sp, err := NewStoragePlace(name, *v)
+	sp, err := NewEquippedStoragePlace(name, *v, equipment)
 	if err != nil {
 		return err
 	}
 	c.storagePlaces = append(c.storagePlaces, sp)
+	c.RaiseDomainEvent(NewStoragePlaceAddedDomainEvent(c, sp))
 	return nil
 }
 
+// RemoveStoragePlace takes an empty place away, a courier always keeps at least one
+func (c *Courier) RemoveStoragePlace(storagePlaceID uuid.UUID) error {
+	if c.status == StatusDeactivated {
+		return ErrCourierStatusIsWrong
+	}
+	place, err := c.findStoragePlaceByID(storagePlaceID)
+	if err != nil {
+		return err
+	}
+	if place.IsOccupied() {
+		return ErrStoragePlaceIsOccupied
+	}
+	if len(c.storagePlaces) == 1 {
+		return ErrLastStoragePlace
+	}
+	c.storagePlaces = slices.DeleteFunc(c.storagePlaces, place.Equal)
+	c.RaiseDomainEvent(NewStoragePlaceRemovedDomainEvent(c, place))
+	return nil
+}
+
+// ResizeStoragePlace changes the volume of an empty place
+func (c *Courier) ResizeStoragePlace(storagePlaceID uuid.UUID, volume int) error {
+	if c.status == StatusDeactivated {
+		return ErrCourierStatusIsWrong
+	}
+	v, err := kernel.NewVolume(volume)
+	if err != nil {
+		return err
+	}
+	place, err := c.findStoragePlaceByID(storagePlaceID)
+	if err != nil {
+		return err
+	}
+	err = place.Resize(*v)
+	if err != nil {
+		return err
+	}
+	c.RaiseDomainEvent(NewStoragePlaceResizedDomainEvent(c, place))
+	return nil
+}
+
+func (c *Courier) findStoragePlaceByID(storagePlaceID uuid.UUID) (*StoragePlace, error) {
+	if storagePlaceID == uuid.Nil {
+		return nil, errs.NewValueIsInvalidError("storagePlaceID")
+	}
+	for _, place := range c.storagePlaces {
+		if place.ID() == storagePlaceID {
+			return place, nil
+		}
+	}
+	return nil, ErrStoragePlaceNotFound
+}
+
 func (c *Courier) CanTakeOrder(order *order.Order) (bool, error) {
 	if order == nil {
 		return false, errs.NewValueIsInvalidError("order")
 	}
+	if !c.IsOnShift() {
+		return false, nil
+	}
+	if !c.canCarry(order.Weight()) {
+		return false, nil
+	}
+	// The warehouse is reachable by any transport, zones limit where the order is delivered
+	if !c.transport.Allows(order.Location()) {
+		return false, nil
+	}
 	for _, place := range c.storagePlaces {
-		canStore, err := place.CanStore(order.Volume())
+		canStore, err := place.CanStore(order.Requirements())
 		if err != nil {
 			return false, err
 		}
@@ -131,28 +410,170 @@ func (c *Courier) TakeOrder(order *order.Order) error {
 		return err
 	}
 	if !canTake {
+		if c.IsOnShift() && !c.canCarry(order.Weight()) {
+			return ErrCourierIsOverloaded
+		}
 		return ErrCourierCanNotTakeOrder
 	}
-	for _, place := range c.storagePlaces {
-		canStore, _ := place.CanStore(order.Volume())
-		if canStore {
-			err = place.Store(order.ID(), order.Volume())
-			if err != nil {
-				return err
-			}
-			courierID := c.id
-			err = order.Assign(&courierID)
-			if err != nil {
-				_ = place.Clear(order.ID())
-				return err
+	place := c.BestFitStoragePlace(order.Requirements())
+	if place == nil {
+		return ErrCourierCanNotTakeOrder
+	}
+	pickup, err := NewStop(order.ID(), StopKindPickup, order.Pickup())
+	if err != nil {
+		return err
+	}
+	dropOff, err := NewStop(order.ID(), StopKindDropOff, order.Location())
+	if err != nil {
+		return err
+	}
+	err = place.Store(order.ID(), order.Requirements())
+	if err != nil {
+		return err
+	}
+	courierID := c.ID()
+	err = order.Assign(&courierID)
+	if err != nil {
+		_ = place.Clear(order.ID())
+		return err
+	}
+	c.planStops(pickup, dropOff)
+	return nil
+}
+
+// planStops inserts pickup and drop-off stops where they make the route shortest,
+// keeping the order of the other stops. Pickup always goes before drop-off
+func (c *Courier) planStops(pickup, dropOff Stop) {
+	var bestRoute []Stop
+	bestLength := math.Inf(1)
+	for i := 0; i <= len(c.stops); i++ {
+		for j := i; j <= len(c.stops); j++ {
+			route := make([]Stop, 0, len(c.stops)+2)
+			route = append(route, c.stops[:i]...)
+			route = append(route, pickup)
+			route = append(route, c.stops[i:j]...)
+			route = append(route, dropOff)
+			route = append(route, c.stops[j:]...)
+			length := routeLength(c.location, route)
+			if length < bestLength {
+				bestLength = length
+				bestRoute = route
 			}
-			return nil
 		}
 	}
-	return ErrCourierCanNotTakeOrder
+	c.stops = bestRoute
+}
+
+func routeLength(from kernel.Location, stops []Stop) float64 {
+	length := 0.0
+	for _, stop := range stops {
+		length += distance(from, stop.Location())
+		from = stop.Location()
+	}
+	return length
+}
+
+// distance is used for route planning only, both locations are valid there
+func distance(from, to kernel.Location) float64 {
+	dist, _ := from.Distance(to)
+	return dist
+}
+
+// MoveAlongRoute moves the courier towards the next due stop and returns the stops which are reached.
+// Stops which are not due yet are passed by. Orders should be picked up or completed by the caller
+func (c *Courier) MoveAlongRoute(now time.Time) ([]Stop, error) {
+	next, ok := c.nextDueStop(now)
+	if !ok {
+		return nil, nil
+	}
+	err := c.Move(next.Location())
+	if err != nil {
+		return nil, err
+	}
+	var reached []Stop
+	for _, stop := range c.stops {
+		if !stop.IsDue(now) {
+			continue
+		}
+		if !stop.Location().Equal(c.location) {
+			break
+		}
+		reached = append(reached, stop)
+	}
+	return reached, nil
+}
+
+func (c *Courier) nextDueStop(now time.Time) (Stop, bool) {
+	for _, stop := range c.stops {
+		if stop.IsDue(now) {
+			return stop, true
+		}
+	}
+	return Stop{}, false
+}
+
+// BestFitStoragePlace returns a free storage place with the least equipment the order does not need
+// and then the smallest one, so that fridges and bigger places stay free for the orders needing them.
+// Returns nil if nothing fits
+func (c *Courier) BestFitStoragePlace(requirements order.Requirements) *StoragePlace {
+	var bestFit *StoragePlace
+	for _, place := range c.storagePlaces {
+		canStore, err := place.CanStore(requirements)
+		if err != nil || !canStore {
+			continue
+		}
+		if bestFit == nil || fitsBetter(place, bestFit, requirements) {
+			bestFit = place
+		}
+	}
+	return bestFit
+}
+
+func fitsBetter(place, other *StoragePlace, requirements order.Requirements) bool {
+	unneeded := place.Equipment().Unneeded(requirements)
+	otherUnneeded := other.Equipment().Unneeded(requirements)
+	if unneeded != otherUnneeded {
+		return unneeded < otherUnneeded
+	}
+	return place.TotalVolume() < other.TotalVolume()
+}
+
+// canCarry checks the total payload, a place may be big enough while the courier can not lift the order
+func (c *Courier) canCarry(weight kernel.Weight) bool {
+	return (c.Payload() + weight).FitsTo(c.maxPayload)
+}
+
+// Load is a share of occupied storage places, from 0 (all free) to 1 (all occupied)
+func (c *Courier) Load() float64 {
+	if len(c.storagePlaces) == 0 {
+		return 1
+	}
+	occupied := 0
+	for _, place := range c.storagePlaces {
+		if place.IsOccupied() {
+			occupied++
+		}
+	}
+	return float64(occupied) / float64(len(c.storagePlaces))
+}
+
+// PickUpOrder removes the pickup stop of a carried order from the route
+func (c *Courier) PickUpOrder(order *order.Order) error {
+	if order == nil {
+		return errs.NewValueIsRequiredError("order")
+	}
+	_, err := c.findStoragePlaceByOrderID(order.ID())
+	if err != nil {
+		return err
+	}
+	c.removeStop(order.ID(), StopKindPickup)
+	return nil
 }
 
 func (c *Courier) CompleteOrder(order *order.Order) error {
+	if order == nil {
+		return errs.NewValueIsRequiredError("order")
+	}
 	sp, err := c.findStoragePlaceByOrderID(order.ID())
 	if err != nil {
 		return err
@@ -161,9 +582,94 @@ func (c *Courier) CompleteOrder(order *order.Order) error {
 	if err != nil {
 		return err
 	}
+	c.removeStop(order.ID(), StopKindPickup)
+	c.removeStop(order.ID(), StopKindDropOff)
 	return nil
 }
 
+// RetryDelivery moves the drop-off of an undelivered order to the end of the route,
+// the parcel stays in its storage place. The drop-off is not visited before notBefore,
+// so that the recipient has time to come back
+func (c *Courier) RetryDelivery(order *order.Order, notBefore time.Time) error {
+	if order == nil {
+		return errs.NewValueIsRequiredError("order")
+	}
+	_, err := c.findStoragePlaceByOrderID(order.ID())
+	if err != nil {
+		return err
+	}
+	dropOff, err := NewStop(order.ID(), StopKindDropOff, order.Location())
+	if err != nil {
+		return err
+	}
+	dropOff.notBefore = notBefore
+	c.removeStop(order.ID(), StopKindDropOff)
+	c.stops = append(c.stops, dropOff)
+	return nil
+}
+
+// ReturnToSender replaces the drop-off of an undelivered order with a way back to pickup location
+func (c *Courier) ReturnToSender(order *order.Order) error {
+	if order == nil {
+		return errs.NewValueIsRequiredError("order")
+	}
+	_, err := c.findStoragePlaceByOrderID(order.ID())
+	if err != nil {
+		return err
+	}
+	back, err := NewStop(order.ID(), StopKindReturn, order.Pickup())
+	if err != nil {
+		return err
+	}
+	c.removeStop(order.ID(), StopKindDropOff)
+	c.stops = append(c.stops, back)
+	return nil
+}
+
+// CompleteReturn frees the storage place when the parcel is back at pickup location
+func (c *Courier) CompleteReturn(order *order.Order) error {
+	if order == nil {
+		return errs.NewValueIsRequiredError("order")
+	}
+	sp, err := c.findStoragePlaceByOrderID(order.ID())
+	if err != nil {
+		return err
+	}
+	err = sp.Clear(order.ID())
+	if err != nil {
+		return err
+	}
+	c.removeStop(order.ID(), StopKindReturn)
+	return nil
+}
+
+// CancelOrder frees the storage place of a cancelled order and drops it from the route
+func (c *Courier) CancelOrder(order *order.Order) error {
+	if order == nil {
+		return errs.NewValueIsRequiredError("order")
+	}
+	sp, err := c.findStoragePlaceByOrderID(order.ID())
+	if err != nil {
+		return err
+	}
+	err = sp.Clear(order.ID())
+	if err != nil {
+		return err
+	}
+	c.removeStop(order.ID(), StopKindPickup)
+	c.removeStop(order.ID(), StopKindDropOff)
+	return nil
+}
+
+func (c *Courier) removeStop(orderID uuid.UUID, kind StopKind) {
+	for i, stop := range c.stops {
+		if stop.OrderID() == orderID && stop.Kind() == kind {
+			c.stops = append(c.stops[:i], c.stops[i+1:]...)
+			return
+		}
+	}
+}
+
 func (c *Courier) CalculateTimeToLocation(target kernel.Location) (float64, error) {
 	if !target.IsValid() {
 		return 0, errs.NewValueIsInvalidError("location")
@@ -172,14 +678,49 @@ func (c *Courier) CalculateTimeToLocation(target kernel.Location) (float64, erro
 	if err != nil {
 		return 0, err
 	}
-	time := math.Ceil(float64(dist) / float64(c.speed))
+	time := math.Ceil(dist / float64(c.speed))
 	return time, nil
 }
 
+// CalculateTimeToDeliver estimates the time to collect the order at pickup location
+// and bring it to drop-off location, leg by leg
+func (c *Courier) CalculateTimeToDeliver(order *order.Order) (float64, error) {
+	if order == nil {
+		return 0, errs.NewValueIsRequiredError("order")
+	}
+	toPickup, err := c.CalculateTimeToLocation(order.Pickup())
+	if err != nil {
+		return 0, err
+	}
+	dist, err := order.Pickup().Distance(order.Location())
+	if err != nil {
+		return 0, err
+	}
+	toDropOff := math.Ceil(dist / float64(c.speed))
+	return toPickup + toDropOff, nil
+}
+
 func (c *Courier) Move(target kernel.Location) error {
 	if !target.IsValid() {
 		return errs.NewValueIsInvalidError("location")
 	}
+	newLocation, err := c.nextLocation(target)
+	if err != nil {
+		return err
+	}
+	if c.location.Equal(newLocation) {
+		return nil
+	}
+	c.location = newLocation
+	c.RaiseDomainEvent(NewCourierMovedDomainEvent(c))
+	return nil
+}
+
+// nextLocation makes one step of speed cells on the grid, or speed kilometres along the great circle for geo locations
+func (c *Courier) nextLocation(target kernel.Location) (kernel.Location, error) {
+	if c.location.IsGeo() {
+		return c.location.StepTowards(target, float64(c.speed))
+	}
 	dx := float64(target.X()) - float64(c.location.X())
 	dy := float64(target.Y()) - float64(c.location.Y())
 	remainingRange := float64(c.speed)
@@ -193,32 +734,13 @@ func (c *Courier) Move(target kernel.Location) error {
 		dy = math.Copysign(remainingRange, dy)
 	}
 
-	// Calculate new coordinates using int to handle negative values correctly
-	newXInt := int(c.location.X()) + int(math.Round(dx))
-	newYInt := int(c.location.Y()) + int(math.Round(dy))
-
-	// Clamp to valid bounds
-	if newXInt < int(kernel.MinX) {
-		newXInt = int(kernel.MinX)
-	} else if newXInt > int(kernel.MaxX) {
-		newXInt = int(kernel.MaxX)
-	}
-
-	if newYInt < int(kernel.MinY) {
-		newYInt = int(kernel.MinY)
-	} else if newYInt > int(kernel.MaxY) {
-		newYInt = int(kernel.MaxY)
-	}
-
-	newX := uint8(newXInt)
-	newY := uint8(newYInt)
+	// Stay within the configured city grid
+	newX, newY := kernel.CurrentGrid().Clamp(
+		c.location.X()+int(math.Round(dx)),
+		c.location.Y()+int(math.Round(dy)),
+	)
 
-	newLocation, err := kernel.NewLocation(newX, newY)
-	if err != nil {
-		return err
-	}
-	c.location = newLocation
-	return nil
+	return kernel.NewLocation(newX, newY)
 }
 
 func (c *Courier) findStoragePlaceByOrderID(orderID uuid.UUID) (*StoragePlace, error) {
@@ -226,7 +748,7 @@ func (c *Courier) findStoragePlaceByOrderID(orderID uuid.UUID) (*StoragePlace, e
 		return nil, errs.NewValueIsInvalidError("orderID")
 	}
 	for _, place := range c.storagePlaces {
-		if *(place.OrderID()) == orderID {
+		if place.IsOccupied() && *(place.OrderID()) == orderID {
 			return place, nil
 		}
 	}
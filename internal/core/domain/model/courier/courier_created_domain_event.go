@@ -0,0 +1,46 @@
+package courier
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &CourierCreatedDomainEvent{}
+
+type CourierCreatedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	CourierID   uuid.UUID
+	CourierName string
+	Speed       int
+	Transport   string
+	LocationX   int
+	LocationY   int
+	Latitude    float64
+	Longitude   float64
+}
+
+func NewCourierCreatedDomainEvent(aggregate *Courier) ddd.DomainEvent {
+	return &CourierCreatedDomainEvent{
+		ID:          uuid.New(),
+		CourierID:   aggregate.ID(),
+		CourierName: aggregate.Name(),
+		Speed:       aggregate.Speed(),
+		Transport:   aggregate.Transport().String(),
+		LocationX:   aggregate.Location().X(),
+		LocationY:   aggregate.Location().Y(),
+		Latitude:    aggregate.Location().Latitude(),
+		Longitude:   aggregate.Location().Longitude(),
+	}
+}
+
+func (e *CourierCreatedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *CourierCreatedDomainEvent) GetName() string {
+	return "CourierCreatedDomainEvent"
+}
@@ -0,0 +1,40 @@
+package courier
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &CourierMovedDomainEvent{}
+
+type CourierMovedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	CourierID uuid.UUID
+	LocationX int
+	LocationY int
+	Latitude  float64
+	Longitude float64
+}
+
+func NewCourierMovedDomainEvent(aggregate *Courier) ddd.DomainEvent {
+	return &CourierMovedDomainEvent{
+		ID:        uuid.New(),
+		CourierID: aggregate.ID(),
+		LocationX: aggregate.Location().X(),
+		LocationY: aggregate.Location().Y(),
+		Latitude:  aggregate.Location().Latitude(),
+		Longitude: aggregate.Location().Longitude(),
+	}
+}
+
+func (e *CourierMovedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *CourierMovedDomainEvent) GetName() string {
+	return "CourierMovedDomainEvent"
+}
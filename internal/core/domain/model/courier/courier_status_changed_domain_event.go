@@ -0,0 +1,34 @@
+package courier
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &CourierStatusChangedDomainEvent{}
+
+type CourierStatusChangedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	CourierID uuid.UUID
+	Status    string
+}
+
+func NewCourierStatusChangedDomainEvent(aggregate *Courier) ddd.DomainEvent {
+	return &CourierStatusChangedDomainEvent{
+		ID:        uuid.New(),
+		CourierID: aggregate.ID(),
+		Status:    aggregate.Status().String(),
+	}
+}
+
+func (e *CourierStatusChangedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *CourierStatusChangedDomainEvent) GetName() string {
+	return "CourierStatusChangedDomainEvent"
+}
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,6 +22,8 @@ const (
 	SpeedHigh = 9
 )
 
+var testAddress, _ = kernel.NewAddress("Россия", "Москва", "Тверская", "1", "1")
+
 func Test_NewCourierOkWithValidParams(t *testing.T) {
 	// Arrange
 	location, err := kernel.RandomLocation()
@@ -146,3 +150,474 @@ func Test_CourierCompleteOrderOK(t *testing.T) {
 	err = c.CompleteOrder(o)
 	assert.NoError(t, err, "completing correct order should be OK")
 }
+
+func Test_NewCourierRaisesDomainEvent(t *testing.T) {
+	c, _ := courier.NewCourier(NameOK, SpeedOK, kernel.MinLocation())
+	events := c.GetDomainEvents()
+	assert.Equal(t, 1, len(events), "new courier should raise one domain event")
+	event, ok := events[0].(*courier.CourierCreatedDomainEvent)
+	assert.True(t, ok, "raised event should be CourierCreatedDomainEvent")
+	assert.Equal(t, c.ID(), event.CourierID, "event should carry courier ID")
+}
+
+func Test_CourierAddStoragePlaceRaisesDomainEvent(t *testing.T) {
+	c := courier.CreateCourierOK()
+	c.ClearDomainEvents()
+	err := c.AddStoragePlace("trunk", courier.BagVolume)
+	assert.NoError(t, err, "should be no error adding normal storage place")
+	events := c.GetDomainEvents()
+	assert.Equal(t, 1, len(events), "adding storage place should raise one domain event")
+	event, ok := events[0].(*courier.StoragePlaceAddedDomainEvent)
+	assert.True(t, ok, "raised event should be StoragePlaceAddedDomainEvent")
+	assert.Equal(t, c.StoragePlaces()[1].ID(), event.StoragePlaceID, "event should carry new storage place ID")
+}
+
+func Test_CourierMoveRaisesDomainEvent(t *testing.T) {
+	c, _ := courier.NewCourier(NameOK, SpeedOK, kernel.MinLocation())
+	c.ClearDomainEvents()
+	err := c.Move(kernel.MaxLocation())
+	assert.NoError(t, err, "should be no error moving to valid location")
+	events := c.GetDomainEvents()
+	assert.Equal(t, 1, len(events), "moving should raise one domain event")
+	_, ok := events[0].(*courier.CourierMovedDomainEvent)
+	assert.True(t, ok, "raised event should be CourierMovedDomainEvent")
+}
+
+func Test_CourierMoveToSameLocationRaisesNoEvent(t *testing.T) {
+	c, _ := courier.NewCourier(NameOK, SpeedOK, kernel.MinLocation())
+	c.ClearDomainEvents()
+	err := c.Move(kernel.MinLocation())
+	assert.NoError(t, err, "should be no error staying at the same location")
+	assert.Empty(t, c.GetDomainEvents(), "staying in place should not raise events")
+}
+
+func Test_CourierTakeOrderUsesBestFitStoragePlace(t *testing.T) {
+	c := courier.CreateCourierOK()
+	_ = c.AddStoragePlace("trailer", 100)
+	_ = c.AddStoragePlace("trunk", 20)
+	volume, _ := kernel.NewVolume(courier.BagVolume + 5)
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, kernel.MinLocation(), kernel.MinLocation(), *volume, 0, kernel.DeliveryPeriod{})
+	err := c.TakeOrder(o)
+	assert.NoError(t, err, "courier should take the order")
+	assert.Equal(t, "trunk", c.StoragePlaces()[2].Name())
+	assert.Equal(t, o.ID(), *c.StoragePlaces()[2].OrderID(), "order should go to the smallest fitting place")
+	assert.Nil(t, c.StoragePlaces()[1].OrderID(), "bigger place should stay free")
+}
+
+func Test_CourierTakesSeveralOrdersAndPlansRoute(t *testing.T) {
+	// Arrange
+	start, _ := kernel.NewLocation(1, 1)
+	c, _ := courier.NewCourier(courier.NameOK, 1, start)
+	_ = c.StartShift()
+	_ = c.AddStoragePlace("trunk", courier.BagVolume)
+	warehouse, _ := kernel.NewLocation(2, 1)
+	far, _ := kernel.NewLocation(5, 1)
+	near, _ := kernel.NewLocation(3, 1)
+	farOrder, _ := order.NewOrder(uuid.New(), testAddress, nil, warehouse, far, kernel.MinVolume, 0, kernel.DeliveryPeriod{})
+	nearOrder, _ := order.NewOrder(uuid.New(), testAddress, nil, warehouse, near, kernel.MinVolume, 0, kernel.DeliveryPeriod{})
+
+	// Act
+	errFar := c.TakeOrder(farOrder)
+	errNear := c.TakeOrder(nearOrder)
+
+	// Assert
+	assert.NoError(t, errFar, "should be no error taking first order")
+	assert.NoError(t, errNear, "should be no error taking second order while trunk is free")
+	stops := c.Stops()
+	assert.Len(t, stops, 4, "every order should get pickup and drop-off stops")
+	assert.Equal(t, courier.StopKindPickup, stops[0].Kind(), "route should start at the warehouse")
+	assert.Equal(t, courier.StopKindPickup, stops[1].Kind(), "both orders should be collected at once")
+	assert.Equal(t, nearOrder.ID(), stops[2].OrderID(), "near order should be delivered on the way")
+	assert.Equal(t, courier.StopKindDropOff, stops[2].Kind())
+	assert.Equal(t, farOrder.ID(), stops[3].OrderID())
+	assert.Equal(t, courier.StopKindDropOff, stops[3].Kind())
+}
+
+func Test_CourierMoveAlongRouteReachesStopsInSequence(t *testing.T) {
+	// Arrange
+	start, _ := kernel.NewLocation(1, 1)
+	c, _ := courier.NewCourier(courier.NameOK, 2, start)
+	_ = c.StartShift()
+	warehouse, _ := kernel.NewLocation(3, 1)
+	destination, _ := kernel.NewLocation(3, 3)
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, warehouse, destination, kernel.MinVolume, 0, kernel.DeliveryPeriod{})
+	_ = c.TakeOrder(o)
+
+	// Act
+	reachedPickup, errPickup := c.MoveAlongRoute(time.Now())
+	_ = c.PickUpOrder(o)
+	reachedDropOff, errDropOff := c.MoveAlongRoute(time.Now())
+	_ = c.CompleteOrder(o)
+	reachedNone, errNone := c.MoveAlongRoute(time.Now())
+
+	// Assert
+	assert.NoError(t, errPickup)
+	assert.NoError(t, errDropOff)
+	assert.NoError(t, errNone)
+	assert.Len(t, reachedPickup, 1, "warehouse should be reached first")
+	assert.Equal(t, courier.StopKindPickup, reachedPickup[0].Kind())
+	assert.Len(t, reachedDropOff, 1, "destination should be reached next")
+	assert.Equal(t, courier.StopKindDropOff, reachedDropOff[0].Kind())
+	assert.Empty(t, reachedNone, "nothing should be reached with empty route")
+	assert.Empty(t, c.Stops(), "completed order should leave the route")
+	assert.Zero(t, c.Load(), "completed order should free storage place")
+}
+
+func Test_CourierCalculateTimeToDeliverCountsBothLegs(t *testing.T) {
+	// Arrange
+	start, _ := kernel.NewLocation(1, 1)
+	c, _ := courier.NewCourier(courier.NameOK, 2, start)
+	warehouse, _ := kernel.NewLocation(4, 1)
+	destination, _ := kernel.NewLocation(4, 4)
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, warehouse, destination, kernel.MinVolume, 0, kernel.DeliveryPeriod{})
+
+	// Act
+	ticks, err := c.CalculateTimeToDeliver(o)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, float64(4), ticks, "both legs of 3 cells should take 2 ticks each")
+}
+
+func Test_CourierCancelOrderFreesStoragePlace(t *testing.T) {
+	// Arrange
+	c := courier.CreateCourierOK()
+	o := order.CreateOrderOK()
+	_ = c.TakeOrder(o)
+
+	// Act
+	err := c.CancelOrder(o)
+
+	// Assert
+	assert.NoError(t, err, "should be no error cancelling carried order")
+	assert.Zero(t, c.Load(), "storage place should be free after cancellation")
+	assert.Empty(t, c.Stops(), "cancelled order should leave the route")
+}
+
+func Test_CourierCancelOrderErrorNotCarried(t *testing.T) {
+	c := courier.CreateCourierOK()
+	err := c.CancelOrder(order.CreateOrderOK())
+	assert.ErrorIs(t, err, courier.ErrNoOrderFound, "should be error cancelling order courier doesn't carry")
+}
+
+func Test_CourierRetryDeliveryMovesDropOffToTheEnd(t *testing.T) {
+	// Arrange
+	c := courier.CreateCourierOK()
+	_ = c.AddStoragePlace("trunk", courier.BagVolume)
+	failed := order.CreateOrderOK()
+	other := order.CreateOrderOK()
+	_ = c.TakeOrder(failed)
+	_ = c.TakeOrder(other)
+	_ = c.PickUpOrder(failed)
+	_ = c.PickUpOrder(other)
+
+	// Act
+	err := c.RetryDelivery(failed, time.Time{})
+
+	// Assert
+	assert.NoError(t, err, "should be no error retrying carried order")
+	stops := c.Stops()
+	last := stops[len(stops)-1]
+	assert.Equal(t, failed.ID(), last.OrderID(), "redelivery should be the last stop")
+	assert.Equal(t, courier.StopKindDropOff, last.Kind())
+	assert.Equal(t, 1.0, c.Load(), "parcel should stay in storage place")
+}
+
+func Test_CourierPassesByRedeliveryUntilItIsDue(t *testing.T) {
+	// Arrange
+	destination, _ := kernel.NewLocation(1, 1)
+	c, _ := courier.NewCourier(courier.NameOK, courier.SpeedOK, destination)
+	_ = c.StartShift()
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, destination, destination, kernel.MinVolume, 0, kernel.DeliveryPeriod{})
+	_ = c.TakeOrder(o)
+	_ = c.PickUpOrder(o)
+	now := time.Now()
+	_ = c.RetryDelivery(o, now.Add(time.Minute))
+
+	// Act
+	reachedBefore, errBefore := c.MoveAlongRoute(now)
+	reachedAfter, errAfter := c.MoveAlongRoute(now.Add(time.Minute))
+
+	// Assert
+	assert.NoError(t, errBefore)
+	assert.NoError(t, errAfter)
+	assert.Empty(t, reachedBefore, "drop-off should not be visited before it is due")
+	assert.Len(t, reachedAfter, 1, "drop-off should be visited when it is due")
+	assert.Equal(t, courier.StopKindDropOff, reachedAfter[0].Kind())
+}
+
+func Test_CourierReturnToSenderFreesPlaceOnlyAtPickup(t *testing.T) {
+	// Arrange
+	c := courier.CreateCourierOK()
+	o := order.CreateOrderOK()
+	_ = c.TakeOrder(o)
+	_ = c.PickUpOrder(o)
+
+	// Act
+	errReturn := c.ReturnToSender(o)
+	stops := c.Stops()
+	loadOnTheWay := c.Load()
+	errComplete := c.CompleteReturn(o)
+
+	// Assert
+	assert.NoError(t, errReturn)
+	assert.NoError(t, errComplete)
+	assert.Len(t, stops, 1, "only the way back should be left")
+	assert.Equal(t, courier.StopKindReturn, stops[0].Kind())
+	assert.Equal(t, o.Pickup(), stops[0].Location(), "parcel should be brought to pickup location")
+	assert.Equal(t, 1.0, loadOnTheWay, "storage place should stay occupied on the way back")
+	assert.Zero(t, c.Load(), "storage place should be free after return")
+	assert.Empty(t, c.Stops())
+}
+
+func Test_CourierMoveStaysWithinConfiguredGrid(t *testing.T) {
+	// Arrange
+	grid, _ := kernel.NewGrid(30, 30)
+	kernel.SetGrid(grid)
+	t.Cleanup(func() { kernel.SetGrid(kernel.DefaultGrid()) })
+	start, _ := kernel.NewLocation(28, 1)
+	target, _ := kernel.NewLocation(30, 1)
+	c, _ := courier.NewCourier(courier.NameOK, 5, start)
+
+	// Act
+	err := c.Move(target)
+
+	// Assert
+	assert.NoError(t, err, "courier should be able to move beyond the default 10x10 grid")
+	assert.True(t, c.Location().Equal(target), "courier should stop at the target on the grid edge")
+}
+
+func Test_CourierMovesByKilometresOnGeoLocations(t *testing.T) {
+	// Arrange
+	start, _ := kernel.NewGeoLocation(55.75, 37.60)
+	target, _ := kernel.NewGeoLocation(55.80, 37.70)
+	c, _ := courier.NewCourier(courier.NameOK, 2, start)
+
+	// Act
+	err := c.Move(target)
+
+	// Assert
+	assert.NoError(t, err, "courier should move between geo locations")
+	travelled, _ := start.Distance(c.Location())
+	assert.InDelta(t, 2, travelled, 1e-6, "courier should travel speed kilometres per step")
+	for range 10 {
+		_ = c.Move(target)
+	}
+	assert.True(t, c.Location().Equal(target), "courier should reach the target in the end")
+}
+
+func Test_NewCourierIsOffDutyAndTakesNoOrders(t *testing.T) {
+	c, _ := courier.NewCourier(NameOK, SpeedOK, kernel.MinLocation())
+
+	canTake, err := c.CanTakeOrder(order.CreateOrderOK())
+
+	assert.NoError(t, err)
+	assert.Equal(t, courier.StatusOffDuty, c.Status(), "new courier should be off duty")
+	assert.False(t, canTake, "courier off duty should not get orders")
+}
+
+func Test_CourierShiftTransitions(t *testing.T) {
+	c, _ := courier.NewCourier(NameOK, SpeedOK, kernel.MinLocation())
+	c.ClearDomainEvents()
+
+	assert.NoError(t, c.StartShift())
+	assert.True(t, c.IsOnShift())
+	assert.ErrorIs(t, c.StartShift(), courier.ErrCourierStatusIsWrong, "shift can not be started twice")
+	assert.NoError(t, c.TakeBreak())
+	assert.Equal(t, courier.StatusOnBreak, c.Status())
+	assert.NoError(t, c.EndBreak())
+	assert.NoError(t, c.EndShift())
+	assert.Equal(t, courier.StatusOffDuty, c.Status())
+	assert.NoError(t, c.Deactivate())
+	assert.ErrorIs(t, c.StartShift(), courier.ErrCourierStatusIsWrong, "deactivated courier can not work")
+	assert.Equal(t, 5, len(c.GetDomainEvents()), "every transition should raise an event")
+	_, ok := c.GetDomainEvents()[0].(*courier.CourierStatusChangedDomainEvent)
+	assert.True(t, ok, "raised event should be CourierStatusChangedDomainEvent")
+}
+
+func Test_CourierCanNotEndShiftWithOrders(t *testing.T) {
+	c := courier.CreateCourierOK()
+	o := order.CreateOrderOK()
+	_ = c.TakeOrder(o)
+
+	err := c.EndShift()
+
+	assert.ErrorIs(t, err, courier.ErrCourierCarriesOrders, "courier should deliver the orders first")
+	assert.True(t, c.IsOnShift())
+}
+
+func Test_CourierCanNotBeDeactivatedOnShift(t *testing.T) {
+	c := courier.CreateCourierOK()
+
+	err := c.Deactivate()
+
+	assert.ErrorIs(t, err, courier.ErrCourierStatusIsWrong, "courier should end the shift first")
+}
+
+func Test_CourierRenameAndChangeSpeed(t *testing.T) {
+	c := courier.CreateCourierOK()
+
+	assert.NoError(t, c.Rename("Другое имя"))
+	assert.NoError(t, c.ChangeSpeed(courier.MaxSpeed))
+	assert.Error(t, c.Rename(""), "empty name should be rejected")
+	assert.Error(t, c.ChangeSpeed(courier.MaxSpeed+1), "speed out of range should be rejected")
+
+	assert.Equal(t, "Другое имя", c.Name())
+	assert.Equal(t, courier.MaxSpeed, c.Speed())
+}
+
+func Test_DeactivatedCourierCanNotBeChanged(t *testing.T) {
+	c, _ := courier.NewCourier(NameOK, SpeedOK, kernel.MinLocation())
+	_ = c.Deactivate()
+
+	assert.ErrorIs(t, c.Rename("Другое имя"), courier.ErrCourierStatusIsWrong)
+	assert.ErrorIs(t, c.ChangeSpeed(courier.MinSpeed), courier.ErrCourierStatusIsWrong)
+	assert.ErrorIs(t, c.AddStoragePlace("trunk", 20), courier.ErrCourierStatusIsWrong)
+	assert.ErrorIs(t, c.RemoveStoragePlace(c.StoragePlaces()[0].ID()), courier.ErrCourierStatusIsWrong)
+}
+
+func Test_CourierRemoveStoragePlace(t *testing.T) {
+	c := courier.CreateCourierOK()
+	_ = c.AddStoragePlace("trunk", 20)
+	trunk := c.StoragePlaces()[1]
+
+	err := c.RemoveStoragePlace(trunk.ID())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(c.StoragePlaces()), "trunk should be removed")
+	assert.ErrorIs(t, c.RemoveStoragePlace(c.StoragePlaces()[0].ID()), courier.ErrLastStoragePlace)
+	assert.ErrorIs(t, c.RemoveStoragePlace(uuid.New()), courier.ErrStoragePlaceNotFound)
+}
+
+func Test_CourierCanNotRemoveOccupiedStoragePlace(t *testing.T) {
+	c := courier.CreateCourierOK()
+	_ = c.AddStoragePlace("trunk", 20)
+	_ = c.TakeOrder(order.CreateOrderOK())
+
+	err := c.RemoveStoragePlace(c.StoragePlaces()[0].ID())
+
+	assert.ErrorIs(t, err, courier.ErrStoragePlaceIsOccupied)
+}
+
+func Test_CourierPutsFrozenGoodsInRefrigeratedPlace(t *testing.T) {
+	// Arrange
+	c := courier.CreateCourierOK()
+	_ = c.AddEquippedStoragePlace("fridge", courier.BagVolume, courier.NewEquipment(true, false, 0))
+	iceCream, _ := order.NewItem(uuid.New(), "Мороженое", 15000, 1, 0, true, false)
+	o, _ := order.NewOrder(
+		uuid.New(), testAddress, []order.Item{iceCream}, kernel.MinLocation(), kernel.MinLocation(), kernel.MinVolume, 0,
+		kernel.DeliveryPeriod{},
+	)
+
+	// Act
+	err := c.TakeOrder(o)
+
+	// Assert
+	assert.NoError(t, err, "courier with a fridge should take frozen goods")
+	assert.Nil(t, c.StoragePlaces()[0].OrderID(), "frozen goods should not go into the bag")
+	assert.Equal(t, o.ID(), *c.StoragePlaces()[1].OrderID())
+}
+
+func Test_CourierKeepsFridgeFreeForFrozenGoods(t *testing.T) {
+	// Arrange: the fridge is smaller than the bag, but an ordinary order does not need it
+	c := courier.CreateCourierOK()
+	_ = c.AddEquippedStoragePlace("fridge", courier.BagVolume-1, courier.NewEquipment(true, false, 0))
+	o, _ := order.NewOrder(
+		uuid.New(), testAddress, nil, kernel.MinLocation(), kernel.MinLocation(), kernel.MinVolume, 0,
+		kernel.DeliveryPeriod{},
+	)
+
+	// Act
+	err := c.TakeOrder(o)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, o.ID(), *c.StoragePlaces()[0].OrderID(), "ordinary order should go into the bag")
+	assert.Nil(t, c.StoragePlaces()[1].OrderID(), "fridge should stay free")
+}
+
+func Test_CourierPutsHeavyOrderInPlaceWithoutWeightLimit(t *testing.T) {
+	// Arrange: the shelf is the smallest fitting place, but the order weight is over its limit
+	c := courier.CreateCourierOK()
+	_ = c.AddEquippedStoragePlace("shelf", courier.BagVolume-1, courier.NewEquipment(false, false, 2000))
+	o, _ := order.NewOrder(
+		uuid.New(), testAddress, nil, kernel.MinLocation(), kernel.MinLocation(), kernel.MinVolume, 5000,
+		kernel.DeliveryPeriod{},
+	)
+
+	// Act
+	err := c.TakeOrder(o)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, kernel.Weight(5000), o.Requirements().Weight(), "order weight should be required from the place")
+	assert.Nil(t, c.StoragePlaces()[1].OrderID(), "heavy order should skip the shelf")
+	assert.Equal(t, o.ID(), *c.StoragePlaces()[0].OrderID())
+}
+
+func Test_CourierWithoutFridgeCanNotTakeFrozenGoods(t *testing.T) {
+	c := courier.CreateCourierOK()
+	iceCream, _ := order.NewItem(uuid.New(), "Мороженое", 15000, 1, 0, true, false)
+	o, _ := order.NewOrder(
+		uuid.New(), testAddress, []order.Item{iceCream}, kernel.MinLocation(), kernel.MinLocation(), kernel.MinVolume, 0,
+		kernel.DeliveryPeriod{},
+	)
+
+	err := c.TakeOrder(o)
+
+	assert.ErrorIs(t, err, courier.ErrCourierCanNotTakeOrder)
+}
+
+func Test_CourierCanNotTakeOrderOverMaxPayload(t *testing.T) {
+	// Arrange: a bag of canned goods fits the bag but is too heavy for a bike courier
+	c := courier.CreateCourierOK()
+	_ = c.ChangeMaxPayload(8000)
+	cans, _ := order.NewOrder(
+		uuid.New(), testAddress, nil, kernel.MinLocation(), kernel.MinLocation(), courier.BagVolume, 12000,
+		kernel.DeliveryPeriod{},
+	)
+
+	// Act
+	canTake, err := c.CanTakeOrder(cans)
+	errTake := c.TakeOrder(cans)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, canTake, "order over max payload should not be taken")
+	assert.ErrorIs(t, errTake, courier.ErrCourierIsOverloaded)
+}
+
+func Test_CourierPayloadAddsUp(t *testing.T) {
+	// Arrange
+	c := courier.CreateCourierOK()
+	_ = c.AddStoragePlace("trunk", 20)
+	_ = c.ChangeMaxPayload(10000)
+	first, _ := order.NewOrder(
+		uuid.New(), testAddress, nil, kernel.MinLocation(), kernel.MinLocation(), kernel.MinVolume, 6000,
+		kernel.DeliveryPeriod{},
+	)
+	second, _ := order.NewOrder(
+		uuid.New(), testAddress, nil, kernel.MinLocation(), kernel.MinLocation(), kernel.MinVolume, 6000,
+		kernel.DeliveryPeriod{},
+	)
+
+	// Act
+	errFirst := c.TakeOrder(first)
+	errSecond := c.TakeOrder(second)
+	_ = c.CompleteOrder(first)
+	errAfterDelivery := c.TakeOrder(second)
+
+	// Assert
+	assert.NoError(t, errFirst)
+	assert.ErrorIs(t, errSecond, courier.ErrCourierIsOverloaded, "payload of both orders is over the limit")
+	assert.NoError(t, errAfterDelivery, "delivered order should not count in the payload")
+	assert.Equal(t, kernel.Weight(6000), c.Payload())
+}
+
+func Test_CourierChangeMaxPayload(t *testing.T) {
+	c := courier.CreateCourierOK()
+
+	assert.Error(t, c.ChangeMaxPayload(-1), "negative max payload should be rejected")
+	assert.NoError(t, c.ChangeMaxPayload(15000))
+	assert.Equal(t, kernel.Weight(15000), c.MaxPayload())
+}
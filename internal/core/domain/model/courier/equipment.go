@@ -0,0 +1,50 @@
+package courier
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
+)
+
+// Equipment is what a storage place offers besides its volume. Zero max weight means no limit
+type Equipment struct {
+	refrigerated bool
+	fragileSafe  bool
+	maxWeight    kernel.Weight
+}
+
+func NewEquipment(refrigerated, fragileSafe bool, maxWeight kernel.Weight) Equipment {
+	return Equipment{
+		refrigerated: refrigerated,
+		fragileSafe:  fragileSafe,
+		maxWeight:    maxWeight,
+	}
+}
+
+func (e Equipment) Refrigerated() bool {
+	return e.refrigerated
+}
+
+func (e Equipment) FragileSafe() bool {
+	return e.fragileSafe
+}
+
+func (e Equipment) MaxWeight() kernel.Weight {
+	return e.maxWeight
+}
+
+// IsEmpty is true for an ordinary place, like a bag
+func (e Equipment) IsEmpty() bool {
+	return e == Equipment{}
+}
+
+// Unneeded counts the features the order can go without, such as a fridge for an order with no frozen goods
+func (e Equipment) Unneeded(requirements order.Requirements) int {
+	unneeded := 0
+	if e.refrigerated && !requirements.Refrigerated() {
+		unneeded++
+	}
+	if e.fragileSafe && !requirements.Fragile() {
+		unneeded++
+	}
+	return unneeded
+}
@@ -0,0 +1,23 @@
+package courier
+
+const (
+	StatusEmpty       Status = ""
+	StatusOffDuty     Status = "OffDuty"
+	StatusOnShift     Status = "OnShift"
+	StatusOnBreak     Status = "OnBreak"
+	StatusDeactivated Status = "Deactivated"
+)
+
+type Status string
+
+func (s Status) Equal(target Status) bool {
+	return s == target
+}
+
+func (s Status) IsEmpty() bool {
+	return s == StatusEmpty
+}
+
+func (s Status) String() string {
+	return string(s)
+}
@@ -0,0 +1,83 @@
+package courier
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/errs"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StopKind string
+
+const (
+	StopKindPickup  StopKind = "Pickup"
+	StopKindDropOff StopKind = "DropOff"
+	// StopKindReturn brings an undelivered parcel back to pickup location
+	StopKindReturn StopKind = "Return"
+)
+
+func (k StopKind) IsValid() bool {
+	return k == StopKindPickup || k == StopKindDropOff || k == StopKindReturn
+}
+
+func (k StopKind) String() string {
+	return string(k)
+}
+
+// Stop is a point of the courier route where an order is collected or delivered.
+// A stop with notBefore set is passed by until that time, zero means any time
+type Stop struct {
+	orderID   uuid.UUID
+	kind      StopKind
+	location  kernel.Location
+	notBefore time.Time
+}
+
+func NewStop(orderID uuid.UUID, kind StopKind, location kernel.Location) (Stop, error) {
+	if orderID == uuid.Nil {
+		return Stop{}, errs.NewValueIsRequiredError("orderID")
+	}
+	if !kind.IsValid() {
+		return Stop{}, errs.NewValueIsInvalidError("kind")
+	}
+	if !location.IsValid() {
+		return Stop{}, errs.NewValueIsInvalidError("location")
+	}
+	return Stop{
+		orderID:  orderID,
+		kind:     kind,
+		location: location,
+	}, nil
+}
+
+// RestoreStop creates from DB record, so no error is expected here
+func RestoreStop(orderID uuid.UUID, kind StopKind, location kernel.Location, notBefore time.Time) Stop {
+	return Stop{
+		orderID:   orderID,
+		kind:      kind,
+		location:  location,
+		notBefore: notBefore,
+	}
+}
+
+func (s Stop) OrderID() uuid.UUID {
+	return s.orderID
+}
+
+func (s Stop) Kind() StopKind {
+	return s.kind
+}
+
+func (s Stop) Location() kernel.Location {
+	return s.location
+}
+
+func (s Stop) NotBefore() time.Time {
+	return s.notBefore
+}
+
+// IsDue tells whether the stop can be visited at the given time
+func (s Stop) IsDue(now time.Time) bool {
+	return !now.Before(s.notBefore)
+}
@@ -3,6 +3,7 @@ package courier
 
 import (
 	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 	"errors"
 
@@ -14,16 +15,25 @@ const (
 	BagName = "bag"
 )
 
-var ErrStoragePlaceNotEmptyOrLargeEnough = errors.New("storage place is occupied or less than necessary")
+var (
+	ErrStoragePlaceNotEmptyOrLargeEnough = errors.New("storage place is occupied or less than necessary")
+	ErrStoragePlaceIsOccupied            = errors.New("storage place is occupied")
+)
 
 type StoragePlace struct {
 	id          uuid.UUID
 	name        string
 	totalVolume kernel.Volume
+	equipment   Equipment
 	orderID     *uuid.UUID
+	orderWeight kernel.Weight
 }
 
 func NewStoragePlace(name string, totalVolume kernel.Volume) (*StoragePlace, error) {
+	return NewEquippedStoragePlace(name, totalVolume, Equipment{})
+}
+
+func NewEquippedStoragePlace(name string, totalVolume kernel.Volume, equipment Equipment) (*StoragePlace, error) {
 	if name == "" {
 		return nil, errs.NewValueIsRequiredError("name")
 	}
@@ -35,16 +45,22 @@ func NewStoragePlace(name string, totalVolume kernel.Volume) (*StoragePlace, err
 		id:          uuid.New(),
 		name:        name,
 		totalVolume: totalVolume,
+		equipment:   equipment,
 	}, nil
 }
 
 // RestoreStoragePlace creates from DB record, so no error is expected here
-func RestoreStoragePlace(name string, totalVolume kernel.Volume, id uuid.UUID, orderID *uuid.UUID) *StoragePlace {
+func RestoreStoragePlace(
+	name string, totalVolume kernel.Volume, equipment Equipment, id uuid.UUID, orderID *uuid.UUID,
+	orderWeight kernel.Weight,
+) *StoragePlace {
 	return &StoragePlace{
 		id: id,
 		name: name,
 		totalVolume: totalVolume,
+		equipment: equipment,
 		orderID: orderID,
+		orderWeight: orderWeight,
 	}
 }
 
@@ -53,7 +69,7 @@ func NewBag() *StoragePlace {
 	return bag
 }
 
-func (s *StoragePlace) isOccupied() bool {
+func (s *StoragePlace) IsOccupied() bool {
 	return s.orderID != nil
 }
 
@@ -69,22 +85,54 @@ func (s *StoragePlace) TotalVolume() kernel.Volume {
 	return s.totalVolume
 }
 
+func (s *StoragePlace) Equipment() Equipment {
+	return s.equipment
+}
+
 func (s *StoragePlace) OrderID() *uuid.UUID {
 	return s.orderID
 }
 
-func (s *StoragePlace) CanStore(volume kernel.Volume) (bool, error) {
-	if !volume.IsValid() {
-		return false, errs.NewValueIsInvalidError("volume")
+// OrderWeight is the weight of the stored order, zero for an empty place
+func (s *StoragePlace) OrderWeight() kernel.Weight {
+	return s.orderWeight
+}
+
+// CanStore checks that the place is free, big enough and equipped for the order
+func (s *StoragePlace) CanStore(requirements order.Requirements) (bool, error) {
+	if !requirements.IsValid() {
+		return false, errs.NewValueIsInvalidError("requirements")
 	}
-	if s.isOccupied() || !volume.FitsTo(&s.totalVolume) {
+	volume := requirements.Volume()
+	if s.IsOccupied() || !volume.FitsTo(&s.totalVolume) {
+		return false, nil
+	}
+	if requirements.Refrigerated() && !s.equipment.Refrigerated() {
+		return false, nil
+	}
+	if requirements.Fragile() && !s.equipment.FragileSafe() {
+		return false, nil
+	}
+	if !requirements.Weight().FitsTo(s.equipment.MaxWeight()) {
 		return false, nil
 	}
 	return true, nil
 }
 
-func (s *StoragePlace) Store(orderID uuid.UUID, volume kernel.Volume) error {
-	canStore, err := s.CanStore(volume)
+// Resize is possible for an empty place only
+func (s *StoragePlace) Resize(totalVolume kernel.Volume) error {
+	if !totalVolume.IsValid() {
+		return errs.NewValueIsInvalidError("totalVolume")
+	}
+	if s.IsOccupied() {
+		return ErrStoragePlaceIsOccupied
+	}
+	s.totalVolume = totalVolume
+	return nil
+}
+
+func (s *StoragePlace) Store(orderID uuid.UUID, requirements order.Requirements) error {
+	canStore, err := s.CanStore(requirements)
 	if err != nil {
 		return err
 	}
@@ -92,6 +140,7 @@ func (s *StoragePlace) Store(orderID uuid.UUID, volume kernel.Volume) error {
 		return ErrStoragePlaceNotEmptyOrLargeEnough
 	}
 	s.orderID = &orderID
+	s.orderWeight = requirements.Weight()
 	return nil
 }
 
@@ -100,6 +149,7 @@ func (s *StoragePlace) Clear(orderID uuid.UUID) error {
 		return errs.NewValueIsInvalidError("orderID")
 	}
 	s.orderID = nil
+	s.orderWeight = 0
 	return nil
 }
 
@@ -0,0 +1,38 @@
+package courier
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &StoragePlaceAddedDomainEvent{}
+
+type StoragePlaceAddedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	CourierID        uuid.UUID
+	StoragePlaceID   uuid.UUID
+	StoragePlaceName string
+	TotalVolume      int
+}
+
+func NewStoragePlaceAddedDomainEvent(aggregate *Courier, storagePlace *StoragePlace) ddd.DomainEvent {
+	return &StoragePlaceAddedDomainEvent{
+		ID:               uuid.New(),
+		CourierID:        aggregate.ID(),
+		StoragePlaceID:   storagePlace.ID(),
+		StoragePlaceName: storagePlace.Name(),
+		TotalVolume:      int(storagePlace.TotalVolume()),
+	}
+}
+
+func (e *StoragePlaceAddedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *StoragePlaceAddedDomainEvent) GetName() string {
+	return "StoragePlaceAddedDomainEvent"
+}
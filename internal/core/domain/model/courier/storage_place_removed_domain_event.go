@@ -0,0 +1,38 @@
+package courier
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &StoragePlaceRemovedDomainEvent{}
+
+type StoragePlaceRemovedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	CourierID        uuid.UUID
+	StoragePlaceID   uuid.UUID
+	StoragePlaceName string
+	TotalVolume      int
+}
+
+func NewStoragePlaceRemovedDomainEvent(aggregate *Courier, storagePlace *StoragePlace) ddd.DomainEvent {
+	return &StoragePlaceRemovedDomainEvent{
+		ID:               uuid.New(),
+		CourierID:        aggregate.ID(),
+		StoragePlaceID:   storagePlace.ID(),
+		StoragePlaceName: storagePlace.Name(),
+		TotalVolume:      int(storagePlace.TotalVolume()),
+	}
+}
+
+func (e *StoragePlaceRemovedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *StoragePlaceRemovedDomainEvent) GetName() string {
+	return "StoragePlaceRemovedDomainEvent"
+}
@@ -0,0 +1,38 @@
+package courier
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &StoragePlaceResizedDomainEvent{}
+
+type StoragePlaceResizedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	CourierID        uuid.UUID
+	StoragePlaceID   uuid.UUID
+	StoragePlaceName string
+	TotalVolume      int
+}
+
+func NewStoragePlaceResizedDomainEvent(aggregate *Courier, storagePlace *StoragePlace) ddd.DomainEvent {
+	return &StoragePlaceResizedDomainEvent{
+		ID:               uuid.New(),
+		CourierID:        aggregate.ID(),
+		StoragePlaceID:   storagePlace.ID(),
+		StoragePlaceName: storagePlace.Name(),
+		TotalVolume:      int(storagePlace.TotalVolume()),
+	}
+}
+
+func (e *StoragePlaceResizedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *StoragePlaceResizedDomainEvent) GetName() string {
+	return "StoragePlaceResizedDomainEvent"
+}
@@ -3,6 +3,7 @@ package courier_test
 import (
 	"delivery/internal/core/domain/kernel"
 	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 	"fmt"
 	"testing"
@@ -20,6 +21,11 @@ const (
 	VolumeWrong = -1
 )
 
+func requirementsOf(volume int) order.Requirements {
+	requirements, _ := order.NewRequirements(kernel.Volume(volume), 0, false, false)
+	return requirements
+}
+
 func Test_NewStoragePlaceCorrectParams(t *testing.T) {
 	// Arrange
 
@@ -71,7 +77,7 @@ func Test_StoragePlaceCanStoreOK(t *testing.T) {
 	
 	// Act
 	sp, _ := courier.NewStoragePlace(NameBag, StandardBag)
-	ok, err := sp.CanStore(VolumeOK)
+	ok, err := sp.CanStore(requirementsOf(VolumeOK))
 
 	// Assert
 	assert.True(t, ok, "Bag (10) must be able to store 5")
@@ -83,7 +89,7 @@ func Test_StoragePlaceCanStoreTooBig(t *testing.T) {
 	
 	// Act
 	sp, _ := courier.NewStoragePlace(NameBag, StandardBag)
-	ok, err := sp.CanStore(VolumeBigger)
+	ok, err := sp.CanStore(requirementsOf(VolumeBigger))
 
 	// Assert
 	assert.False(t, ok, "Bag (10) must not be able to store 15")
@@ -95,7 +101,7 @@ func Test_StoragePlaceCanStoreWrongVolume(t *testing.T) {
 	
 	// Act
 	sp, _ := courier.NewStoragePlace(NameBag, StandardBag)
-	ok, err := sp.CanStore(VolumeWrong)
+	ok, err := sp.CanStore(requirementsOf(VolumeWrong))
 
 	// Assert
 	assert.False(t, ok, "Cannot place wrong volume to storage_place")
@@ -115,16 +121,16 @@ func Test_StoragePlaceEqualNot(t *testing.T) {
 
 func Test_StoreOK(t *testing.T) {
 	sp, _ := courier.NewStoragePlace(NameBag, StandardBag)
-	err := sp.Store(uuid.New(), VolumeOK)
+	err := sp.Store(uuid.New(), requirementsOf(VolumeOK))
 	assert.NoError(t, err, "No error expected placing 5 to empty bag 10")
 }
 
 
 func Test_StoreOccupied(t *testing.T) {
 	sp, _ := courier.NewStoragePlace(NameBag, StandardBag)
-	err := sp.Store(uuid.New(), VolumeOK)
+	err := sp.Store(uuid.New(), requirementsOf(VolumeOK))
 	assert.NoError(t, err, "No error expected placing 5 to empty bag 10")
-	err = sp.Store(uuid.New(), VolumeOK)
+	err = sp.Store(uuid.New(), requirementsOf(VolumeOK))
 	assert.Error(t, err, "Error expected placing new volume to occupied SP")
 	assert.ErrorIs(t, err, courier.ErrStoragePlaceNotEmptyOrLargeEnough, fmt.Sprintf("expected %v, got %v", courier.ErrStoragePlaceNotEmptyOrLargeEnough, err))
 }
@@ -132,7 +138,7 @@ func Test_StoreOccupied(t *testing.T) {
 func Test_ClearOK(t *testing.T) {
 	sp, _ := courier.NewStoragePlace(NameBag, StandardBag)
 	id := uuid.New()
-	_ = sp.Store(id, VolumeOK)
+	_ = sp.Store(id, requirementsOf(VolumeOK))
 	err := sp.Clear(id)
 	assert.NoError(t, err, "No error expected clearing SP with correct ID")
 }
@@ -140,7 +146,41 @@ func Test_ClearOK(t *testing.T) {
 func Test_ClearWrongID(t *testing.T) {
 	sp, _ := courier.NewStoragePlace(NameBag, StandardBag)
 	id := uuid.New()
-	_ = sp.Store(id, VolumeOK)
+	_ = sp.Store(id, requirementsOf(VolumeOK))
 	err := sp.Clear(uuid.New())
 	assert.Error(t, err, "Should be error clearing SP with wrong ID")
 }
+
+func Test_StoragePlaceCanStoreMatchesEquipment(t *testing.T) {
+	// Arrange
+	frozen, _ := order.NewRequirements(VolumeOK, 0, true, false)
+	fragile, _ := order.NewRequirements(VolumeOK, 0, false, true)
+	heavy, _ := order.NewRequirements(VolumeOK, 6000, false, false)
+	bag, _ := courier.NewStoragePlace(NameBag, StandardBag)
+	fridge, _ := courier.NewEquippedStoragePlace("fridge", StandardBag, courier.NewEquipment(true, false, 5000))
+
+	// Act
+	bagTakesFrozen, _ := bag.CanStore(frozen)
+	bagTakesFragile, _ := bag.CanStore(fragile)
+	bagTakesHeavy, _ := bag.CanStore(heavy)
+	fridgeTakesFrozen, _ := fridge.CanStore(frozen)
+	fridgeTakesHeavy, _ := fridge.CanStore(heavy)
+
+	// Assert
+	assert.False(t, bagTakesFrozen, "frozen goods need a refrigerated place")
+	assert.False(t, bagTakesFragile, "fragile goods need a fragile-safe place")
+	assert.True(t, bagTakesHeavy, "place without max weight takes any weight")
+	assert.True(t, fridgeTakesFrozen, "refrigerated place takes frozen goods")
+	assert.False(t, fridgeTakesHeavy, "order heavier than max weight should not fit")
+}
+
+func Test_StoragePlaceResize(t *testing.T) {
+	sp, _ := courier.NewStoragePlace(NameBag, StandardBag)
+
+	err := sp.Resize(VolumeBigger)
+
+	assert.NoError(t, err)
+	assert.Equal(t, kernel.Volume(VolumeBigger), sp.TotalVolume())
+	_ = sp.Store(uuid.New(), requirementsOf(VolumeOK))
+	assert.ErrorIs(t, sp.Resize(StandardBag), courier.ErrStoragePlaceIsOccupied, "occupied place should not be resized")
+}
@@ -0,0 +1,103 @@
+package courier
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"slices"
+)
+
+const (
+	TransportEmpty      Transport = ""
+	TransportPedestrian Transport = "Pedestrian"
+	TransportBicycle    Transport = "Bicycle"
+	TransportScooter    Transport = "Scooter"
+	TransportCar        Transport = "Car"
+)
+
+const (
+	BoxName     = "box"
+	BoxVolume   = 20
+	TrunkName   = "trunk"
+	TrunkVolume = 50
+)
+
+// Transport sets the profile of a new courier: speed, storage places, payload and zones
+type Transport string
+
+func (t Transport) IsValid() bool {
+	switch t {
+	case TransportPedestrian, TransportBicycle, TransportScooter, TransportCar:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t Transport) Equal(target Transport) bool {
+	return t == target
+}
+
+func (t Transport) IsEmpty() bool {
+	return t == TransportEmpty
+}
+
+func (t Transport) String() string {
+	return string(t)
+}
+
+func (t Transport) DefaultSpeed() int {
+	switch t {
+	case TransportPedestrian:
+		return 1
+	case TransportBicycle:
+		return 2
+	case TransportScooter:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// DefaultMaxPayload is what the courier can lift, zero means the car is limited by its storage places only
+func (t Transport) DefaultMaxPayload() kernel.Weight {
+	switch t {
+	case TransportPedestrian:
+		return 5000
+	case TransportBicycle:
+		return 10000
+	case TransportScooter:
+		return 20000
+	default:
+		return 0
+	}
+}
+
+// DefaultStoragePlaces are created anew on every call, as each courier owns its places
+func (t Transport) DefaultStoragePlaces() []*StoragePlace {
+	places := []*StoragePlace{NewBag()}
+	switch t {
+	case TransportScooter:
+		box, _ := NewStoragePlace(BoxName, BoxVolume)
+		places = append(places, box)
+	case TransportCar:
+		trunk, _ := NewStoragePlace(TrunkName, TrunkVolume)
+		places = append(places, trunk)
+	}
+	return places
+}
+
+// AllowedZones are the rings of the city the courier delivers to in reasonable time
+func (t Transport) AllowedZones() []kernel.Zone {
+	switch t {
+	case TransportPedestrian:
+		return []kernel.Zone{kernel.ZoneCenter}
+	case TransportBicycle:
+		return []kernel.Zone{kernel.ZoneCenter, kernel.ZoneCity}
+	default:
+		return []kernel.Zone{kernel.ZoneCenter, kernel.ZoneCity, kernel.ZoneSuburbs}
+	}
+}
+
+// Allows tells whether the courier delivers to the location
+func (t Transport) Allows(location kernel.Location) bool {
+	return slices.Contains(t.AllowedZones(), kernel.ZoneOf(location))
+}
@@ -0,0 +1,65 @@
+package courier_test
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCourierOnTransportTakesProfile(t *testing.T) {
+	tests := map[string]struct {
+		transport     courier.Transport
+		speed         int
+		storagePlaces int
+		maxPayload    kernel.Weight
+	}{
+		"pedestrian": {courier.TransportPedestrian, 1, 1, 5000},
+		"bicycle":    {courier.TransportBicycle, 2, 1, 10000},
+		"scooter":    {courier.TransportScooter, 3, 2, 20000},
+		"car":        {courier.TransportCar, 4, 2, 0},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, err := courier.NewCourierOnTransport(NameOK, test.transport, 0, kernel.MinLocation())
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.transport, c.Transport())
+			assert.Equal(t, test.speed, c.Speed(), "speed should be the default of the transport")
+			assert.Equal(t, test.storagePlaces, len(c.StoragePlaces()))
+			assert.Equal(t, test.maxPayload, c.MaxPayload())
+		})
+	}
+}
+
+func Test_NewCourierOnTransportKeepsSpeedAndChecksTransport(t *testing.T) {
+	c, _ := courier.NewCourierOnTransport(NameOK, courier.TransportBicycle, 3, kernel.MinLocation())
+	assert.Equal(t, 3, c.Speed(), "given speed should override the default one")
+
+	_, err := courier.NewCourierOnTransport(NameOK, "Helicopter", 0, kernel.MinLocation())
+	assert.Equal(t, errs.NewValueIsInvalidError("transport"), err)
+}
+
+func Test_PedestrianCourierDeliversInCenterOnly(t *testing.T) {
+	// Arrange: the warehouse is at the configured pickup location in the suburbs
+	pickup := kernel.MinLocation()
+	center, _ := kernel.NewLocation(5, 5)
+	suburbs, _ := kernel.NewLocation(10, 10)
+	c, _ := courier.NewCourierOnTransport(NameOK, courier.TransportPedestrian, 0, center)
+	_ = c.StartShift()
+	near, _ := order.NewOrder(uuid.New(), testAddress, nil, pickup, center, kernel.MinVolume, 0, kernel.DeliveryPeriod{})
+	far, _ := order.NewOrder(uuid.New(), testAddress, nil, pickup, suburbs, kernel.MinVolume, 0, kernel.DeliveryPeriod{})
+
+	// Act
+	canTakeNear, _ := c.CanTakeOrder(near)
+	canTakeFar, _ := c.CanTakeOrder(far)
+
+	// Assert
+	assert.Equal(t, kernel.ZoneSuburbs, kernel.ZoneOf(pickup), "pickup should be out of the pedestrian zones")
+	assert.True(t, canTakeNear, "pedestrian should deliver in the center wherever the warehouse is")
+	assert.False(t, canTakeFar, "pedestrian should not deliver to the suburbs")
+}
@@ -0,0 +1,58 @@
+package order
+
+import "time"
+
+// FailureReason is a code of why the parcel was not handed over
+type FailureReason string
+
+const (
+	FailureReasonNone            FailureReason = ""
+	FailureReasonRecipientAbsent FailureReason = "RecipientAbsent"
+	FailureReasonAddressNotFound FailureReason = "AddressNotFound"
+	FailureReasonRefused         FailureReason = "Refused"
+	FailureReasonDamaged         FailureReason = "Damaged"
+)
+
+func (r FailureReason) IsValid() bool {
+	switch r {
+	case FailureReasonRecipientAbsent, FailureReasonAddressNotFound, FailureReasonRefused, FailureReasonDamaged:
+		return true
+	}
+	return false
+}
+
+func (r FailureReason) String() string {
+	return string(r)
+}
+
+// DeliveryAttempt is a record of one try to hand the parcel over
+type DeliveryAttempt struct {
+	number      int
+	reason      FailureReason
+	attemptedAt time.Time
+}
+
+// RestoreDeliveryAttempt creates from DB record, so no error is expected here
+func RestoreDeliveryAttempt(number int, reason FailureReason, attemptedAt time.Time) DeliveryAttempt {
+	return DeliveryAttempt{
+		number:      number,
+		reason:      reason,
+		attemptedAt: attemptedAt,
+	}
+}
+
+func (a DeliveryAttempt) Number() int {
+	return a.number
+}
+
+func (a DeliveryAttempt) Succeeded() bool {
+	return a.reason == FailureReasonNone
+}
+
+func (a DeliveryAttempt) Reason() FailureReason {
+	return a.reason
+}
+
+func (a DeliveryAttempt) AttemptedAt() time.Time {
+	return a.attemptedAt
+}
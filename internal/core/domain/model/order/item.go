@@ -0,0 +1,121 @@
+package order
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/errs"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Item is a line of the order manifest the courier checks at handover.
+// Price is per unit in minor currency units, so that declared value adds up exactly.
+// Weight is per unit in grams, zero when unknown.
+// Frozen goods go in refrigerated storage places only, fragile ones in fragile-safe places
+type Item struct {
+	goodID   uuid.UUID
+	title    string
+	price    int64
+	quantity int
+	weight   kernel.Weight
+	frozen   bool
+	fragile  bool
+}
+
+func NewItem(goodID uuid.UUID, title string, price int64, quantity int, weight kernel.Weight, frozen, fragile bool) (Item, error) {
+	if goodID == uuid.Nil {
+		return Item{}, errs.NewValueIsRequiredError("goodID")
+	}
+	if strings.TrimSpace(title) == "" {
+		return Item{}, errs.NewValueIsRequiredError("title")
+	}
+	if price < 0 {
+		return Item{}, errs.NewValueIsInvalidError("price")
+	}
+	if quantity < 1 {
+		return Item{}, errs.NewValueIsInvalidError("quantity")
+	}
+	if weight < 0 {
+		return Item{}, errs.NewValueIsInvalidError("weight")
+	}
+	return Item{
+		goodID:   goodID,
+		title:    strings.TrimSpace(title),
+		price:    price,
+		quantity: quantity,
+		weight:   weight,
+		frozen:   frozen,
+		fragile:  fragile,
+	}, nil
+}
+
+// RestoreItem creates from DB record, so no error is expected here
+func RestoreItem(goodID uuid.UUID, title string, price int64, quantity int, weight kernel.Weight, frozen, fragile bool) Item {
+	return Item{
+		goodID:   goodID,
+		title:    title,
+		price:    price,
+		quantity: quantity,
+		weight:   weight,
+		frozen:   frozen,
+		fragile:  fragile,
+	}
+}
+
+func (i Item) GoodID() uuid.UUID {
+	return i.goodID
+}
+
+func (i Item) Title() string {
+	return i.title
+}
+
+func (i Item) Price() int64 {
+	return i.price
+}
+
+func (i Item) Quantity() int {
+	return i.quantity
+}
+
+// Weight is per unit, zero when it is not known
+func (i Item) Weight() kernel.Weight {
+	return i.weight
+}
+
+func (i Item) IsFrozen() bool {
+	return i.frozen
+}
+
+func (i Item) IsFragile() bool {
+	return i.fragile
+}
+
+// Total is the declared value of the line
+func (i Item) Total() int64 {
+	return i.price * int64(i.quantity)
+}
+
+func (i Item) TotalWeight() kernel.Weight {
+	return i.weight * kernel.Weight(i.quantity)
+}
+
+// VolumeOfItems is used when the basket did not report the volume.
+// Every unit of goods is counted as one unit of volume
+func VolumeOfItems(items []Item) kernel.Volume {
+	var volume kernel.Volume
+	for _, item := range items {
+		volume += kernel.Volume(item.quantity)
+	}
+	return volume
+}
+
+// WeightOfItems is used when the basket did not report the weight.
+// Items of unknown weight are not counted
+func WeightOfItems(items []Item) kernel.Weight {
+	var weight kernel.Weight
+	for _, item := range items {
+		weight += item.TotalWeight()
+	}
+	return weight
+}
@@ -3,8 +3,11 @@ package order
 
 import (
 	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -15,51 +18,96 @@ const (
 	VolumeOK = 5
 )
 
+var _ ddd.AggregateRoot = &Order{}
+
 type Order struct {
-	id        uuid.UUID
-	courierID *uuid.UUID
-	location  kernel.Location
-	volume    kernel.Volume
-	status    Status
+	baseAggregate *ddd.BaseAggregate[uuid.UUID]
+	courierID     *uuid.UUID
+	address       kernel.Address
+	items         []Item
+	pickup        kernel.Location
+	location      kernel.Location
+	volume        kernel.Volume
+	weight        kernel.Weight
+	status        Status
+	attempts      []DeliveryAttempt
+
+	deliveryPeriod       kernel.DeliveryPeriod
+	deliveryPeriodMissed bool
 }
 
-func NewOrder(orderID uuid.UUID, location kernel.Location, volume kernel.Volume) (*Order, error) {
+// NewOrder creates an order to be collected at pickup location and delivered to address,
+// geocoded as location, within delivery period. Empty delivery period means any time.
+// Items may be empty when the manifest is not known, zero weight means it is not known either
+func NewOrder(
+	orderID uuid.UUID, address kernel.Address, items []Item, pickup kernel.Location, location kernel.Location, volume kernel.Volume,
+	weight kernel.Weight, deliveryPeriod kernel.DeliveryPeriod,
+) (*Order, error) {
 	if orderID == uuid.Nil {
 		return nil, errs.NewValueIsInvalidError("orderID")
 	}
+	if address.IsEmpty() {
+		return nil, errs.NewValueIsRequiredError("address")
+	}
+	if !pickup.IsValid() {
+		return nil, errs.NewValueIsInvalidError("pickup")
+	}
 	if !location.IsValid() {
 		return nil, errs.NewValueIsInvalidError("location")
 	}
 	if !volume.IsValid() {
 		return nil, errs.NewValueIsInvalidError("volume")
 	}
-	return &Order{
-		id:       orderID,
-		location: location,
-		volume:   volume,
-		status:   StatusCreated,
-	}, nil
+	if weight < 0 {
+		return nil, errs.NewValueIsInvalidError("weight")
+	}
+	o := &Order{
+		baseAggregate: ddd.NewBaseAggregate(orderID),
+		address:       address,
+		items:         slices.Clone(items),
+		pickup:        pickup,
+		location:      location,
+		volume:        volume,
+		weight:        weight,
+		status:        StatusCreated,
+
+		deliveryPeriod: deliveryPeriod,
+	}
+	o.RaiseDomainEvent(NewOrderCreatedDomainEvent(o))
+	return o, nil
 }
 
 // RestoreOrder for restoring from DB record, so no error expected
 func RestoreOrder(
-	orderID uuid.UUID, courierID *uuid.UUID, location kernel.Location, volume kernel.Volume, status Status,
+	orderID uuid.UUID, courierID *uuid.UUID, address kernel.Address, items []Item, pickup kernel.Location,
+	location kernel.Location, volume kernel.Volume, weight kernel.Weight, status Status, attempts []DeliveryAttempt, deliveryPeriod kernel.DeliveryPeriod, deliveryPeriodMissed bool,
+	version int64,
 ) *Order {
 	return &Order{
-		id:        orderID,
-		courierID: courierID,
-		location:  location,
-		volume:    volume,
-		status:    status,
+		baseAggregate: ddd.RestoreBaseAggregate(orderID, version),
+		courierID:     courierID,
+		address:       address,
+		items:         items,
+		pickup:        pickup,
+		location:      location,
+		volume:        volume,
+		weight:        weight,
+		status:        status,
+		attempts:      attempts,
+
+		deliveryPeriod:       deliveryPeriod,
+		deliveryPeriodMissed: deliveryPeriodMissed,
 	}
 }
 
 // CreateOrderOK may be used for testing as normal order object w/o errors
 func CreateOrderOK() *Order {
 	orderID := uuid.New()
+	address, _ := kernel.NewAddress("Россия", "Москва", "Тверская", "1", "1")
+	pickup, _ := kernel.RandomLocation()
 	location, _ := kernel.RandomLocation()
 	volume, _ := kernel.NewVolume(VolumeOK)
-	o, _ := NewOrder(orderID, location, *volume)
+	o, _ := NewOrder(orderID, address, nil, pickup, location, *volume, 0, kernel.DeliveryPeriod{})
 	return o
 }
 
@@ -67,43 +115,218 @@ func (o *Order) Equal(target *Order) bool {
 	if target == nil {
 		return false
 	}
-	return o.id == target.id
+	return o.baseAggregate.Equal(target.baseAggregate)
 }
 
 func (o *Order) ID() uuid.UUID {
-	return o.id
+	return o.baseAggregate.ID()
+}
+
+func (o *Order) Version() int64 {
+	return o.baseAggregate.Version()
+}
+
+func (o *Order) SetVersion(version int64) {
+	o.baseAggregate.SetVersion(version)
 }
 
 func (o *Order) CourierID() *uuid.UUID {
 	return o.courierID
 }
 
+// Address is where the customer expects the order, couriers need it to find the door
+func (o *Order) Address() kernel.Address {
+	return o.address
+}
+
+// Pickup is where the courier collects the order
+func (o *Order) Pickup() kernel.Location {
+	return o.pickup
+}
+
+// Location is where the order is delivered to
 func (o *Order) Location() kernel.Location {
 	return o.location
 }
 
+// Items is the manifest the courier checks at handover
+func (o *Order) Items() []Item {
+	return slices.Clone(o.items)
+}
+
+// TotalPrice is the declared value of the order
+func (o *Order) TotalPrice() int64 {
+	var total int64
+	for _, item := range o.items {
+		total += item.Total()
+	}
+	return total
+}
+
 func (o *Order) Volume() kernel.Volume {
 	return o.volume
 }
 
+// Weight is zero when it is not known, then any storage place and courier can carry the order
+func (o *Order) Weight() kernel.Weight {
+	return o.weight
+}
+
+// Requirements follow from the goods: one frozen or fragile item is enough to need a special storage place
+func (o *Order) Requirements() Requirements {
+	requirements := Requirements{volume: o.volume, weight: o.weight}
+	for _, item := range o.items {
+		requirements.refrigerated = requirements.refrigerated || item.IsFrozen()
+		requirements.fragile = requirements.fragile || item.IsFragile()
+	}
+	return requirements
+}
+
 func (o *Order) Status() Status {
 	return o.status
 }
 
+func (o *Order) DeliveryPeriod() kernel.DeliveryPeriod {
+	return o.deliveryPeriod
+}
+
+func (o *Order) IsDeliveryPeriodMissed() bool {
+	return o.deliveryPeriodMissed
+}
+
+// DeliveryAttempts is the history of handing the parcel over, oldest first
+func (o *Order) DeliveryAttempts() []DeliveryAttempt {
+	attempts := make([]DeliveryAttempt, len(o.attempts))
+	copy(attempts, o.attempts)
+	return attempts
+}
+
+func (o *Order) FailedAttempts() int {
+	failed := 0
+	for _, attempt := range o.attempts {
+		if !attempt.Succeeded() {
+			failed++
+		}
+	}
+	return failed
+}
+
+func (o *Order) GetDomainEvents() []ddd.DomainEvent {
+	return o.baseAggregate.GetDomainEvents()
+}
+
+func (o *Order) ClearDomainEvents() {
+	o.baseAggregate.ClearDomainEvents()
+}
+
+func (o *Order) RaiseDomainEvent(event ddd.DomainEvent) {
+	o.baseAggregate.RaiseDomainEvent(event)
+}
+
 func (o *Order) Assign(courierID *uuid.UUID) error {
-	// TODO: do any validation here?
 	if courierID == nil || *courierID == uuid.Nil {
 		return errs.NewValueIsInvalidError("courierID")
 	}
+	if o.status != StatusCreated {
+		return ErrOrderStatusIsWrongForAction
+	}
 	o.status = StatusAssigned
 	o.courierID = courierID
+	o.RaiseDomainEvent(NewOrderAssignedDomainEvent(o))
 	return nil
 }
 
-func (o *Order) Complete() error {
+// PickUp marks the order as collected by the courier at pickup location
+func (o *Order) PickUp() error {
 	if o.status != StatusAssigned {
 		return ErrOrderStatusIsWrongForAction
 	}
+	o.status = StatusPickedUp
+	o.RaiseDomainEvent(NewOrderPickedUpDomainEvent(o))
+	return nil
+}
+
+func (o *Order) Complete() error {
+	if o.status != StatusPickedUp && o.status != StatusDeliveryFailed {
+		return ErrOrderStatusIsWrongForAction
+	}
+	attempt := o.recordAttempt(FailureReasonNone)
+	o.CheckDeliveryPeriod(attempt.AttemptedAt())
 	o.status = StatusCompleted
+	o.RaiseDomainEvent(NewOrderCompletedDomainEvent(o))
+	return nil
+}
+
+// Cancel is possible until the courier has collected the order
+func (o *Order) Cancel() error {
+	if o.status != StatusCreated && o.status != StatusAssigned {
+		return ErrOrderStatusIsWrongForAction
+	}
+	o.status = StatusCancelled
+	o.RaiseDomainEvent(NewOrderCancelledDomainEvent(o))
+	return nil
+}
+
+// FailDelivery records that the courier could not hand the parcel over. The parcel stays
+// with the courier, who either tries again or brings it back, see CanBeRedelivered
+func (o *Order) FailDelivery(reason FailureReason) error {
+	if !reason.IsValid() {
+		return errs.NewValueIsInvalidError("reason")
+	}
+	if o.status != StatusPickedUp && o.status != StatusDeliveryFailed {
+		return ErrOrderStatusIsWrongForAction
+	}
+	attempt := o.recordAttempt(reason)
+	o.status = StatusDeliveryFailed
+	o.RaiseDomainEvent(NewOrderDeliveryFailedDomainEvent(o, attempt))
 	return nil
 }
+
+// CanBeRedelivered tells whether one more attempt is allowed after a failed one
+func (o *Order) CanBeRedelivered(maxAttempts int) bool {
+	return o.status == StatusDeliveryFailed && o.FailedAttempts() < maxAttempts
+}
+
+// ReturnToSender is done when the courier has brought the parcel back to pickup location
+func (o *Order) ReturnToSender() error {
+	if o.status != StatusDeliveryFailed {
+		return ErrOrderStatusIsWrongForAction
+	}
+	o.status = StatusReturnedToSender
+	o.RaiseDomainEvent(NewOrderReturnedToSenderDomainEvent(o))
+	return nil
+}
+
+func (o *Order) recordAttempt(reason FailureReason) DeliveryAttempt {
+	attempt := DeliveryAttempt{
+		number:      len(o.attempts) + 1,
+		reason:      reason,
+		attemptedAt: time.Now().UTC(),
+	}
+	o.attempts = append(o.attempts, attempt)
+	return attempt
+}
+
+// CheckDeliveryPeriod flags the order once its delivery period has ended before the parcel
+// was handed over. Returns true if the order has just been flagged
+func (o *Order) CheckDeliveryPeriod(now time.Time) bool {
+	if !o.deliveryPeriod.IsMissedAt(now) {
+		return false
+	}
+	return o.MissDeliveryPeriod()
+}
+
+// MissDeliveryPeriod flags the order which will not be delivered within its period,
+// such as one given to a courier who can not arrive in time. Returns true if the order has just been flagged
+func (o *Order) MissDeliveryPeriod() bool {
+	if o.deliveryPeriodMissed || o.deliveryPeriod.IsEmpty() {
+		return false
+	}
+	switch o.status {
+	case StatusCompleted, StatusCancelled, StatusReturnedToSender:
+		return false
+	}
+	o.deliveryPeriodMissed = true
+	o.RaiseDomainEvent(NewOrderDeliveryPeriodMissedDomainEvent(o))
+	return true
+}
@@ -0,0 +1,36 @@
+package order
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &OrderAssignedDomainEvent{}
+
+type OrderAssignedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	OrderID     uuid.UUID
+	CourierID   uuid.UUID
+	OrderStatus string
+}
+
+func NewOrderAssignedDomainEvent(aggregate *Order) ddd.DomainEvent {
+	return &OrderAssignedDomainEvent{
+		ID:          uuid.New(),
+		OrderID:     aggregate.ID(),
+		CourierID:   *aggregate.CourierID(),
+		OrderStatus: aggregate.Status().String(),
+	}
+}
+
+func (e *OrderAssignedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderAssignedDomainEvent) GetName() string {
+	return "OrderAssignedDomainEvent"
+}
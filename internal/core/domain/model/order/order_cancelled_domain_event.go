@@ -0,0 +1,34 @@
+package order
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &OrderCancelledDomainEvent{}
+
+type OrderCancelledDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	OrderID     uuid.UUID
+	OrderStatus string
+}
+
+func NewOrderCancelledDomainEvent(aggregate *Order) ddd.DomainEvent {
+	return &OrderCancelledDomainEvent{
+		ID:          uuid.New(),
+		OrderID:     aggregate.ID(),
+		OrderStatus: aggregate.Status().String(),
+	}
+}
+
+func (e *OrderCancelledDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderCancelledDomainEvent) GetName() string {
+	return "OrderCancelledDomainEvent"
+}
@@ -0,0 +1,34 @@
+package order
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &OrderCompletedDomainEvent{}
+
+type OrderCompletedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	OrderID     uuid.UUID
+	OrderStatus string
+}
+
+func NewOrderCompletedDomainEvent(aggregate *Order) ddd.DomainEvent {
+	return &OrderCompletedDomainEvent{
+		ID:          uuid.New(),
+		OrderID:     aggregate.ID(),
+		OrderStatus: aggregate.Status().String(),
+	}
+}
+
+func (e *OrderCompletedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderCompletedDomainEvent) GetName() string {
+	return "OrderCompletedDomainEvent"
+}
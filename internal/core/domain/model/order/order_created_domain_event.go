@@ -0,0 +1,34 @@
+package order
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &OrderCreatedDomainEvent{}
+
+type OrderCreatedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	OrderID     uuid.UUID
+	OrderStatus string
+}
+
+func NewOrderCreatedDomainEvent(aggregate *Order) ddd.DomainEvent {
+	return &OrderCreatedDomainEvent{
+		ID:          uuid.New(),
+		OrderID:     aggregate.ID(),
+		OrderStatus: aggregate.Status().String(),
+	}
+}
+
+func (e *OrderCreatedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderCreatedDomainEvent) GetName() string {
+	return "OrderCreatedDomainEvent"
+}
@@ -0,0 +1,38 @@
+package order
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &OrderDeliveryFailedDomainEvent{}
+
+type OrderDeliveryFailedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	OrderID     uuid.UUID
+	OrderStatus string
+	Attempt     int
+	Reason      string
+}
+
+func NewOrderDeliveryFailedDomainEvent(aggregate *Order, attempt DeliveryAttempt) ddd.DomainEvent {
+	return &OrderDeliveryFailedDomainEvent{
+		ID:          uuid.New(),
+		OrderID:     aggregate.ID(),
+		OrderStatus: aggregate.Status().String(),
+		Attempt:     attempt.Number(),
+		Reason:      attempt.Reason().String(),
+	}
+}
+
+func (e *OrderDeliveryFailedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderDeliveryFailedDomainEvent) GetName() string {
+	return "OrderDeliveryFailedDomainEvent"
+}
@@ -0,0 +1,37 @@
+package order
+
+import (
+	"delivery/internal/pkg/ddd"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &OrderDeliveryPeriodMissedDomainEvent{}
+
+type OrderDeliveryPeriodMissedDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	OrderID     uuid.UUID
+	OrderStatus string
+	PeriodTo    time.Time
+}
+
+func NewOrderDeliveryPeriodMissedDomainEvent(aggregate *Order) ddd.DomainEvent {
+	return &OrderDeliveryPeriodMissedDomainEvent{
+		ID:          uuid.New(),
+		OrderID:     aggregate.ID(),
+		OrderStatus: aggregate.Status().String(),
+		PeriodTo:    aggregate.DeliveryPeriod().To(),
+	}
+}
+
+func (e *OrderDeliveryPeriodMissedDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderDeliveryPeriodMissedDomainEvent) GetName() string {
+	return "OrderDeliveryPeriodMissedDomainEvent"
+}
@@ -0,0 +1,34 @@
+package order
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &OrderPickedUpDomainEvent{}
+
+type OrderPickedUpDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	OrderID     uuid.UUID
+	OrderStatus string
+}
+
+func NewOrderPickedUpDomainEvent(aggregate *Order) ddd.DomainEvent {
+	return &OrderPickedUpDomainEvent{
+		ID:          uuid.New(),
+		OrderID:     aggregate.ID(),
+		OrderStatus: aggregate.Status().String(),
+	}
+}
+
+func (e *OrderPickedUpDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderPickedUpDomainEvent) GetName() string {
+	return "OrderPickedUpDomainEvent"
+}
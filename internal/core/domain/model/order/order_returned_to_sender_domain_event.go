@@ -0,0 +1,34 @@
+package order
+
+import (
+	"delivery/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+var _ ddd.DomainEvent = &OrderReturnedToSenderDomainEvent{}
+
+type OrderReturnedToSenderDomainEvent struct {
+	// base
+	ID uuid.UUID
+
+	// payload
+	OrderID     uuid.UUID
+	OrderStatus string
+}
+
+func NewOrderReturnedToSenderDomainEvent(aggregate *Order) ddd.DomainEvent {
+	return &OrderReturnedToSenderDomainEvent{
+		ID:          uuid.New(),
+		OrderID:     aggregate.ID(),
+		OrderStatus: aggregate.Status().String(),
+	}
+}
+
+func (e *OrderReturnedToSenderDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e *OrderReturnedToSenderDomainEvent) GetName() string {
+	return "OrderReturnedToSenderDomainEvent"
+}
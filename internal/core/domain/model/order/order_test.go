@@ -6,6 +6,7 @@ import (
 	"delivery/internal/pkg/errs"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +16,8 @@ const (
 	VolumeOK = 5
 )
 
+var testAddress, _ = kernel.NewAddress("Россия", "Москва", "Тверская", "1", "1")
+
 func Test_NewOrderOkWithValidParams(t *testing.T) {
 	// Arrange
 	orderID := uuid.New()
@@ -24,7 +27,7 @@ func Test_NewOrderOkWithValidParams(t *testing.T) {
 	assert.NoError(t, err, "should be no error creating new volume")
 
 	// Act
-	o, err := order.NewOrder(orderID, locations, *volume)
+	o, err := order.NewOrder(orderID, testAddress, nil, locations, locations, *volume, 0, kernel.DeliveryPeriod{})
 
 	// Assert
 	assert.NoError(t, err, "should be no error creating Order with valid params")
@@ -66,7 +69,7 @@ func Test_NewOrderErrorsWithWrongParams(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			_, err := order.NewOrder(test.orderID, test.location, test.volume)
+			_, err := order.NewOrder(test.orderID, testAddress, nil, okLocation, test.location, test.volume, 0, kernel.DeliveryPeriod{})
 			assert.Equal(t, test.expected, err, fmt.Sprintf("expected %v, got %v", test.expected, err))
 		})
 	}
@@ -94,8 +97,9 @@ func Test_OrderCompleteOK(t *testing.T) {
 	o := order.CreateOrderOK()
 	courierID := uuid.New()
 	_ = o.Assign(&courierID)
+	_ = o.PickUp()
 	err := o.Complete()
-	assert.NoError(t, err, "should be no error compliting assigned order")
+	assert.NoError(t, err, "should be no error compliting picked up order")
 	assert.Equal(t, order.StatusCompleted, o.Status(), "order status should be 'Completed' after completion")
 }
 
@@ -106,3 +110,318 @@ func Test_OrderCompleteErrorNotAssigned(t *testing.T) {
 	assert.Equal(t, order.ErrOrderStatusIsWrongForAction, err, fmt.Sprintf(
 		"expected %v, got %v", order.ErrOrderStatusIsWrongForAction, err))
 }
+
+func Test_NewOrderErrorWrongPickup(t *testing.T) {
+	location, _ := kernel.RandomLocation()
+	_, err := order.NewOrder(uuid.New(), testAddress, nil, kernel.Location{}, location, kernel.Volume(VolumeOK), 0, kernel.DeliveryPeriod{})
+	assert.Equal(t, errs.NewValueIsInvalidError("pickup"), err, "pickup location should be validated")
+}
+
+func Test_NewOrderErrorEmptyAddress(t *testing.T) {
+	location, _ := kernel.RandomLocation()
+	_, err := order.NewOrder(uuid.New(), kernel.Address{}, nil, location, location, kernel.Volume(VolumeOK), 0, kernel.DeliveryPeriod{})
+	assert.Equal(t, errs.NewValueIsRequiredError("address"), err, "address should be required")
+}
+
+func Test_OrderPickUpOK(t *testing.T) {
+	o := order.CreateOrderOK()
+	courierID := uuid.New()
+	_ = o.Assign(&courierID)
+	o.ClearDomainEvents()
+	err := o.PickUp()
+	assert.NoError(t, err, "should be no error picking up assigned order")
+	assert.Equal(t, order.StatusPickedUp, o.Status(), "order status should be 'PickedUp' after pickup")
+	events := o.GetDomainEvents()
+	assert.Equal(t, 1, len(events), "picking up should raise one domain event")
+	_, ok := events[0].(*order.OrderPickedUpDomainEvent)
+	assert.True(t, ok, "raised event should be OrderPickedUpDomainEvent")
+}
+
+func Test_OrderPickUpErrorNotAssigned(t *testing.T) {
+	o := order.CreateOrderOK()
+	err := o.PickUp()
+	assert.ErrorIs(t, err, order.ErrOrderStatusIsWrongForAction, "should not pick up order without courier")
+}
+
+func Test_OrderCompleteErrorNotPickedUp(t *testing.T) {
+	o := order.CreateOrderOK()
+	courierID := uuid.New()
+	_ = o.Assign(&courierID)
+	err := o.Complete()
+	assert.ErrorIs(t, err, order.ErrOrderStatusIsWrongForAction, "should not complete order before pickup")
+}
+
+func Test_NewOrderRaisesDomainEvent(t *testing.T) {
+	o := order.CreateOrderOK()
+	events := o.GetDomainEvents()
+	assert.Equal(t, 1, len(events), "new order should raise one domain event")
+	event, ok := events[0].(*order.OrderCreatedDomainEvent)
+	assert.True(t, ok, "raised event should be OrderCreatedDomainEvent")
+	assert.Equal(t, o.ID(), event.OrderID, "event should carry order ID")
+}
+
+func Test_OrderAssignRaisesDomainEvent(t *testing.T) {
+	o := order.CreateOrderOK()
+	o.ClearDomainEvents()
+	courierID := uuid.New()
+	err := o.Assign(&courierID)
+	assert.NoError(t, err, "should be no error assigning courier to order")
+	events := o.GetDomainEvents()
+	assert.Equal(t, 1, len(events), "assigning should raise one domain event")
+	event, ok := events[0].(*order.OrderAssignedDomainEvent)
+	assert.True(t, ok, "raised event should be OrderAssignedDomainEvent")
+	assert.Equal(t, o.ID(), event.OrderID, "event should carry order ID")
+	assert.Equal(t, courierID, event.CourierID, "event should carry courier ID")
+	assert.Equal(t, order.StatusAssigned.String(), event.OrderStatus, "event should carry new status")
+}
+
+func Test_OrderAssignErrorAlreadyAssigned(t *testing.T) {
+	o := order.CreateOrderOK()
+	o.ClearDomainEvents()
+	courierID := uuid.New()
+	_ = o.Assign(&courierID)
+	err := o.Assign(&courierID)
+	assert.ErrorIs(t, err, order.ErrOrderStatusIsWrongForAction, "should not assign order twice")
+	assert.Equal(t, 1, len(o.GetDomainEvents()), "failed assignment should not raise event")
+}
+
+func Test_OrderCompleteRaisesDomainEvent(t *testing.T) {
+	o := order.CreateOrderOK()
+	courierID := uuid.New()
+	_ = o.Assign(&courierID)
+	_ = o.PickUp()
+	o.ClearDomainEvents()
+	err := o.Complete()
+	assert.NoError(t, err, "should be no error completing picked up order")
+	events := o.GetDomainEvents()
+	assert.Equal(t, 1, len(events), "completing should raise one domain event")
+	event, ok := events[0].(*order.OrderCompletedDomainEvent)
+	assert.True(t, ok, "raised event should be OrderCompletedDomainEvent")
+	assert.Equal(t, order.StatusCompleted.String(), event.OrderStatus, "event should carry new status")
+}
+
+func Test_RestoreOrderKeepsVersion(t *testing.T) {
+	location, _ := kernel.RandomLocation()
+	o := order.RestoreOrder(uuid.New(), nil, testAddress, nil, location, location, kernel.Volume(VolumeOK), 0, order.StatusCreated, nil, kernel.DeliveryPeriod{}, false, 7)
+	assert.Equal(t, int64(7), o.Version(), "restored order should keep stored version")
+	assert.Empty(t, o.GetDomainEvents(), "restored order should not raise events")
+}
+
+func Test_OrderCancelFromCreatedAndAssigned(t *testing.T) {
+	created := order.CreateOrderOK()
+	assigned := order.CreateOrderOK()
+	courierID := uuid.New()
+	_ = assigned.Assign(&courierID)
+	assigned.ClearDomainEvents()
+
+	errCreated := created.Cancel()
+	errAssigned := assigned.Cancel()
+
+	assert.NoError(t, errCreated, "should be no error cancelling created order")
+	assert.NoError(t, errAssigned, "should be no error cancelling assigned order")
+	assert.Equal(t, order.StatusCancelled, created.Status())
+	assert.Equal(t, order.StatusCancelled, assigned.Status())
+	events := assigned.GetDomainEvents()
+	assert.Equal(t, 1, len(events), "cancelling should raise one domain event")
+	_, ok := events[0].(*order.OrderCancelledDomainEvent)
+	assert.True(t, ok, "raised event should be OrderCancelledDomainEvent")
+}
+
+func Test_OrderCancelErrorAfterPickUp(t *testing.T) {
+	o := order.CreateOrderOK()
+	courierID := uuid.New()
+	_ = o.Assign(&courierID)
+	_ = o.PickUp()
+	err := o.Cancel()
+	assert.ErrorIs(t, err, order.ErrOrderStatusIsWrongForAction, "should not cancel order which is on the way")
+	assert.Equal(t, order.StatusPickedUp, o.Status(), "status should stay the same")
+}
+
+func Test_OrderFailDeliveryRecordsAttempts(t *testing.T) {
+	o := order.CreateOrderOK()
+	courierID := uuid.New()
+	_ = o.Assign(&courierID)
+	_ = o.PickUp()
+	o.ClearDomainEvents()
+
+	errFirst := o.FailDelivery(order.FailureReasonRecipientAbsent)
+	canRetry := o.CanBeRedelivered(2)
+	errSecond := o.FailDelivery(order.FailureReasonRefused)
+
+	assert.NoError(t, errFirst, "should be no error failing picked up order")
+	assert.NoError(t, errSecond, "should be no error failing redelivery")
+	assert.True(t, canRetry, "one more attempt should be allowed after the first failure")
+	assert.False(t, o.CanBeRedelivered(2), "no attempts should be left after the second failure")
+	assert.Equal(t, order.StatusDeliveryFailed, o.Status())
+	attempts := o.DeliveryAttempts()
+	assert.Len(t, attempts, 2, "every attempt should be recorded")
+	assert.Equal(t, 1, attempts[0].Number())
+	assert.Equal(t, order.FailureReasonRecipientAbsent, attempts[0].Reason())
+	assert.Equal(t, order.FailureReasonRefused, attempts[1].Reason())
+	events := o.GetDomainEvents()
+	assert.Len(t, events, 2, "every failure should raise domain event")
+	event, ok := events[1].(*order.OrderDeliveryFailedDomainEvent)
+	assert.True(t, ok, "raised event should be OrderDeliveryFailedDomainEvent")
+	assert.Equal(t, 2, event.Attempt, "event should carry attempt number")
+}
+
+func Test_OrderFailDeliveryErrorWrongReason(t *testing.T) {
+	o := order.CreateOrderOK()
+	courierID := uuid.New()
+	_ = o.Assign(&courierID)
+	_ = o.PickUp()
+	err := o.FailDelivery(order.FailureReasonNone)
+	assert.Equal(t, errs.NewValueIsInvalidError("reason"), err, "failure should have a reason")
+}
+
+func Test_OrderCompleteAfterFailedAttempt(t *testing.T) {
+	o := order.CreateOrderOK()
+	courierID := uuid.New()
+	_ = o.Assign(&courierID)
+	_ = o.PickUp()
+	_ = o.FailDelivery(order.FailureReasonRecipientAbsent)
+
+	err := o.Complete()
+
+	assert.NoError(t, err, "redelivery should complete the order")
+	assert.Equal(t, order.StatusCompleted, o.Status())
+	attempts := o.DeliveryAttempts()
+	assert.Len(t, attempts, 2)
+	assert.True(t, attempts[1].Succeeded(), "last attempt should be successful")
+}
+
+func Test_OrderReturnToSender(t *testing.T) {
+	o := order.CreateOrderOK()
+	courierID := uuid.New()
+	_ = o.Assign(&courierID)
+	_ = o.PickUp()
+	errNotFailed := o.ReturnToSender()
+	_ = o.FailDelivery(order.FailureReasonAddressNotFound)
+
+	err := o.ReturnToSender()
+
+	assert.ErrorIs(t, errNotFailed, order.ErrOrderStatusIsWrongForAction, "only failed order can be returned")
+	assert.NoError(t, err, "should be no error returning failed order")
+	assert.Equal(t, order.StatusReturnedToSender, o.Status())
+}
+
+func Test_OrderCheckDeliveryPeriodFlagsOnce(t *testing.T) {
+	location, _ := kernel.RandomLocation()
+	from := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	period, _ := kernel.NewDeliveryPeriod(from, from.Add(3*time.Hour))
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, location, location, kernel.Volume(VolumeOK), 0, period)
+	o.ClearDomainEvents()
+
+	inside := o.CheckDeliveryPeriod(from.Add(time.Hour))
+	after := o.CheckDeliveryPeriod(from.Add(4 * time.Hour))
+	again := o.CheckDeliveryPeriod(from.Add(5 * time.Hour))
+
+	assert.False(t, inside, "order should not be flagged inside its period")
+	assert.True(t, after, "order should be flagged after its period")
+	assert.False(t, again, "order should be flagged only once")
+	assert.True(t, o.IsDeliveryPeriodMissed())
+	assert.Len(t, o.GetDomainEvents(), 1, "flagging should raise one domain event")
+}
+
+func Test_OrderCheckDeliveryPeriodIgnoresFinishedOrder(t *testing.T) {
+	location, _ := kernel.RandomLocation()
+	from := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	period, _ := kernel.NewDeliveryPeriod(from, from.Add(3*time.Hour))
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, location, location, kernel.Volume(VolumeOK), 0, period)
+	_ = o.Cancel()
+
+	flagged := o.CheckDeliveryPeriod(from.Add(4 * time.Hour))
+
+	assert.False(t, flagged, "cancelled order should not be flagged")
+}
+
+func Test_OrderMissDeliveryPeriodBeforePeriodEnds(t *testing.T) {
+	location, _ := kernel.RandomLocation()
+	from := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	period, _ := kernel.NewDeliveryPeriod(from, from.Add(3*time.Hour))
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, location, location, kernel.Volume(VolumeOK), 0, period)
+	o.ClearDomainEvents()
+
+	flagged := o.MissDeliveryPeriod()
+	afterPeriod := o.CheckDeliveryPeriod(from.Add(4 * time.Hour))
+
+	assert.True(t, flagged, "order should be flagged before its period ends")
+	assert.False(t, afterPeriod, "order already flagged should not be flagged again")
+	assert.True(t, o.IsDeliveryPeriodMissed())
+	assert.Len(t, o.GetDomainEvents(), 1, "flagging should raise one domain event")
+}
+
+func Test_NewItemErrors(t *testing.T) {
+	tests := map[string]struct {
+		goodID   uuid.UUID
+		title    string
+		price    int64
+		quantity int
+		expected error
+	}{
+		"no_good":        {goodID: uuid.Nil, title: "Milk", price: 100, quantity: 1, expected: errs.NewValueIsRequiredError("goodID")},
+		"no_title":       {goodID: uuid.New(), title: " ", price: 100, quantity: 1, expected: errs.NewValueIsRequiredError("title")},
+		"negative_price": {goodID: uuid.New(), title: "Milk", price: -1, quantity: 1, expected: errs.NewValueIsInvalidError("price")},
+		"zero_quantity":  {goodID: uuid.New(), title: "Milk", price: 100, quantity: 0, expected: errs.NewValueIsInvalidError("quantity")},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := order.NewItem(test.goodID, test.title, test.price, test.quantity, 0, false, false)
+			assert.Equal(t, test.expected, err)
+		})
+	}
+}
+
+func Test_OrderItemsAndTotalPrice(t *testing.T) {
+	location, _ := kernel.RandomLocation()
+	milk, _ := order.NewItem(uuid.New(), "Milk", 8990, 2, 0, false, false)
+	bread, _ := order.NewItem(uuid.New(), "Bread", 4550, 1, 0, false, false)
+	items := []order.Item{milk, bread}
+
+	o, err := order.NewOrder(
+		uuid.New(), testAddress, items, location, location, order.VolumeOfItems(items), 0, kernel.DeliveryPeriod{},
+	)
+	items[0] = bread
+
+	assert.NoError(t, err)
+	assert.Equal(t, []order.Item{milk, bread}, o.Items(), "order should keep its own copy of items")
+	assert.Equal(t, int64(2*8990+4550), o.TotalPrice())
+	assert.Equal(t, kernel.Volume(3), o.Volume(), "every unit of goods should count as one unit of volume")
+}
+
+func Test_OrderRequirementsFollowItems(t *testing.T) {
+	milk, _ := order.NewItem(uuid.New(), "Milk", 8990, 2, 0, false, false)
+	iceCream, _ := order.NewItem(uuid.New(), "Ice cream", 15000, 1, 0, true, false)
+	eggs, _ := order.NewItem(uuid.New(), "Eggs", 12000, 1, 0, false, true)
+	o, _ := order.NewOrder(
+		uuid.New(), testAddress, []order.Item{milk, iceCream, eggs}, kernel.MinLocation(), kernel.MinLocation(),
+		kernel.MinVolume, 0, kernel.DeliveryPeriod{},
+	)
+
+	requirements := o.Requirements()
+
+	assert.True(t, requirements.Refrigerated(), "frozen item should need a refrigerated place")
+	assert.True(t, requirements.Fragile(), "fragile item should need a fragile-safe place")
+	assert.Equal(t, kernel.Volume(kernel.MinVolume), requirements.Volume())
+}
+
+func Test_OrderWeightIsInRequirements(t *testing.T) {
+	location := kernel.MinLocation()
+	_, err := order.NewOrder(uuid.New(), testAddress, nil, location, location, kernel.Volume(VolumeOK), -1, kernel.DeliveryPeriod{})
+	assert.Equal(t, errs.NewValueIsInvalidError("weight"), err, "negative weight should be rejected")
+
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, location, location, kernel.Volume(VolumeOK), 12000, kernel.DeliveryPeriod{})
+
+	assert.Equal(t, kernel.Weight(12000), o.Weight())
+	assert.Equal(t, kernel.Weight(12000), o.Requirements().Weight())
+}
+
+func Test_WeightOfItems(t *testing.T) {
+	cans, _ := order.NewItem(uuid.New(), "Canned beans", 9900, 12, 400, false, false)
+	napkins, _ := order.NewItem(uuid.New(), "Napkins", 5000, 1, 0, false, false)
+
+	weight := order.WeightOfItems([]order.Item{cans, napkins})
+
+	assert.Equal(t, kernel.Weight(4800), weight, "items of unknown weight should not be counted")
+}
@@ -0,0 +1,50 @@
+package order
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/pkg/errs"
+)
+
+// Requirements is what a storage place must offer to carry the order
+type Requirements struct {
+	volume       kernel.Volume
+	weight       kernel.Weight
+	refrigerated bool
+	fragile      bool
+}
+
+func NewRequirements(volume kernel.Volume, weight kernel.Weight, refrigerated, fragile bool) (Requirements, error) {
+	if !volume.IsValid() {
+		return Requirements{}, errs.NewValueIsInvalidError("volume")
+	}
+	if weight < 0 {
+		return Requirements{}, errs.NewValueIsInvalidError("weight")
+	}
+	return Requirements{
+		volume:       volume,
+		weight:       weight,
+		refrigerated: refrigerated,
+		fragile:      fragile,
+	}, nil
+}
+
+func (r Requirements) Volume() kernel.Volume {
+	return r.volume
+}
+
+func (r Requirements) Weight() kernel.Weight {
+	return r.weight
+}
+
+// Refrigerated is needed for frozen goods
+func (r Requirements) Refrigerated() bool {
+	return r.refrigerated
+}
+
+func (r Requirements) Fragile() bool {
+	return r.fragile
+}
+
+func (r Requirements) IsValid() bool {
+	return r.volume.IsValid()
+}
@@ -1,10 +1,14 @@
 package order
 
 const (
-	StatusEmpty     Status = ""
-	StatusCreated   Status = "Created"
-	StatusAssigned  Status = "Assigned"
-	StatusCompleted Status = "Completed"
+	StatusEmpty            Status = ""
+	StatusCreated          Status = "Created"
+	StatusAssigned         Status = "Assigned"
+	StatusPickedUp         Status = "PickedUp"
+	StatusCompleted        Status = "Completed"
+	StatusCancelled        Status = "Cancelled"
+	StatusDeliveryFailed   Status = "DeliveryFailed"
+	StatusReturnedToSender Status = "ReturnedToSender"
 )
 
 type Status string
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/assignment"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"math"
+)
+
+var ErrCouriersAreBusy = errors.New("all suitable couriers got other orders")
+
+type Assignment struct {
+	Order   *order.Order
+	Courier *courier.Courier
+}
+
+type UnassignedOrder struct {
+	Order  *order.Order
+	Reason error
+}
+
+type BatchDispatchResult struct {
+	Assigned   []Assignment
+	Unassigned []UnassignedOrder
+}
+
+// DispatchBatch assigns orders to couriers as a whole, minimizing the total travel time.
+// Every courier gets at most one order per batch, bulky orders prefer cars
+func (d *orderDispatcherService) DispatchBatch(orders []*order.Order, couriers []*courier.Courier) (BatchDispatchResult, error) {
+	result := BatchDispatchResult{}
+	if len(orders) == 0 {
+		return result, nil
+	}
+
+	costs := make([][]float64, len(orders))
+	hasCandidates := make([]bool, len(orders))
+	isDue := make([]bool, len(orders))
+	isLate := make([][]bool, len(orders))
+	for i, o := range orders {
+		if o == nil {
+			return BatchDispatchResult{}, errs.NewValueIsRequiredError("order")
+		}
+		costs[i] = make([]float64, len(couriers))
+		isDue[i] = d.window.IsDue(o)
+		isLate[i] = make([]bool, len(couriers))
+		hasOnTime := false
+		for j, c := range couriers {
+			costs[i][j] = assignment.Forbidden
+			if !isDue[i] {
+				continue
+			}
+			ok, err := c.CanTakeOrder(o)
+			if err != nil || !ok {
+				continue
+			}
+			time, err := c.CalculateTimeToDeliver(o)
+			if err != nil {
+				continue
+			}
+			costs[i][j] = time
+			hasCandidates[i] = true
+			isLate[i][j] = !d.window.CanArriveInTime(c, o)
+			hasOnTime = hasOnTime || !isLate[i][j]
+		}
+		// Late couriers are used only when nobody is in time and the policy allows it,
+		// then the earliest arrival wins
+		if hasOnTime || !d.window.AllowsLate() {
+			for j := range couriers {
+				if isLate[i][j] {
+					costs[i][j] = assignment.Forbidden
+				}
+			}
+			hasCandidates[i] = hasCandidates[i] && (hasOnTime || d.window.AllowsLate())
+		}
+		addTransportPenalty(o, couriers, costs[i])
+	}
+
+	matches := assignment.MinCost(costs)
+	for i, j := range matches {
+		o := orders[i]
+		if j < 0 {
+			reason := ErrCouriersAreBusy
+			if !isDue[i] {
+				reason = ErrOrderIsNotDue
+			} else if !hasCandidates[i] {
+				reason = ErrCourierNotFound
+			}
+			result.Unassigned = append(result.Unassigned, UnassignedOrder{Order: o, Reason: reason})
+			continue
+		}
+
+		c := couriers[j]
+		if err := c.TakeOrder(o); err != nil {
+			result.Unassigned = append(result.Unassigned, UnassignedOrder{Order: o, Reason: err})
+			continue
+		}
+		if isLate[i][j] {
+			o.MissDeliveryPeriod()
+		}
+		result.Assigned = append(result.Assigned, Assignment{Order: o, Courier: c})
+	}
+
+	return result, nil
+}
+
+// addTransportPenalty makes any other transport cost more for a bulky order than the slowest car,
+// so the order goes by another transport only when no car can take it
+func addTransportPenalty(o *order.Order, couriers []*courier.Courier, costs []float64) {
+	penalty := 0.0
+	for _, cost := range costs {
+		if !math.IsInf(cost, 1) {
+			penalty = max(penalty, cost)
+		}
+	}
+	for j, c := range couriers {
+		if isWrongTransport(o, c) {
+			costs[j] += penalty + 1
+		}
+	}
+}
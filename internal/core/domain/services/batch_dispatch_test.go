@@ -0,0 +1,122 @@
+package services
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var testAddress, _ = kernel.NewAddress("Россия", "Москва", "Тверская", "1", "1")
+
+func newTestOrderAt(x, y int, volume int) *order.Order {
+	location, _ := kernel.NewLocation(x, y)
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, location, location, kernel.Volume(volume), 0, kernel.DeliveryPeriod{})
+	return o
+}
+
+func newTestCourierAt(name string, x, y int) *courier.Courier {
+	location, _ := kernel.NewLocation(x, y)
+	c, _ := courier.NewCourier(name, 1, location)
+	_ = c.StartShift()
+	return c
+}
+
+func Test_DispatchBatchFindsGlobalOptimum(t *testing.T) {
+	// Arrange
+	// Greedy would give first order to the nearest courier and make the total time 1+4
+	first := newTestOrderAt(3, 1, kernel.MinVolume)
+	second := newTestOrderAt(5, 1, kernel.MinVolume)
+	near := newTestCourierAt("near", 4, 1)
+	far := newTestCourierAt("far", 1, 1)
+
+	// Act
+	result, err := NewOrderDispatcherService().DispatchBatch(
+		[]*order.Order{first, second}, []*courier.Courier{near, far},
+	)
+
+	// Assert
+	assert.NoError(t, err, "should be no error dispatching batch")
+	assert.Empty(t, result.Unassigned, "all orders should be assigned")
+	assert.Len(t, result.Assigned, 2, "both orders should be assigned")
+	assert.Equal(t, far.ID(), *first.CourierID(), "first order should go to the far courier")
+	assert.Equal(t, near.ID(), *second.CourierID(), "second order should go to the near courier")
+}
+
+func Test_DispatchBatchMoreOrdersThanCouriers(t *testing.T) {
+	// Arrange
+	orders := []*order.Order{
+		newTestOrderAt(1, 1, kernel.MinVolume),
+		newTestOrderAt(9, 9, kernel.MinVolume),
+		newTestOrderAt(10, 10, kernel.MinVolume),
+	}
+	c := newTestCourierAt("single", 10, 10)
+
+	// Act
+	result, err := NewOrderDispatcherService().DispatchBatch(orders, []*courier.Courier{c})
+
+	// Assert
+	assert.NoError(t, err, "should be no error dispatching batch")
+	assert.Len(t, result.Assigned, 1, "only one order should be assigned")
+	assert.Equal(t, orders[2], result.Assigned[0].Order, "nearest order should be assigned")
+	assert.Len(t, result.Unassigned, 2, "other orders should stay unassigned")
+	for _, unassigned := range result.Unassigned {
+		assert.ErrorIs(t, unassigned.Reason, ErrCouriersAreBusy, "courier should be reported as busy")
+	}
+}
+
+func Test_DispatchBatchReportsOrderWithoutSuitableCourier(t *testing.T) {
+	// Arrange
+	small := newTestOrderAt(1, 1, kernel.MinVolume)
+	huge := newTestOrderAt(1, 1, courier.BagVolume+1)
+	c := newTestCourierAt("bag only", 2, 2)
+
+	// Act
+	result, err := NewOrderDispatcherService().DispatchBatch(
+		[]*order.Order{small, huge}, []*courier.Courier{c},
+	)
+
+	// Assert
+	assert.NoError(t, err, "should be no error dispatching batch")
+	assert.Len(t, result.Assigned, 1, "small order should be assigned")
+	assert.Len(t, result.Unassigned, 1, "huge order should stay unassigned")
+	assert.Equal(t, huge, result.Unassigned[0].Order)
+	assert.ErrorIs(t, result.Unassigned[0].Reason, ErrCourierNotFound, "no courier can carry huge order")
+}
+
+func Test_DispatchBatchSendsBulkyOrderByCar(t *testing.T) {
+	// Arrange: the scooter is next to the order, the car is far away
+	location, _ := kernel.NewLocation(5, 5)
+	bulky, _ := order.NewOrder(uuid.New(), testAddress, nil, location, location, BulkyOrderVolume, 0, kernel.DeliveryPeriod{})
+	scooter, _ := courier.NewCourierOnTransport("scooter", courier.TransportScooter, 0, location)
+	_ = scooter.StartShift()
+	car, _ := courier.NewCourierOnTransport("car", courier.TransportCar, courier.MinSpeed, kernel.MaxLocation())
+	_ = car.StartShift()
+	dispatcher := NewOrderDispatcherService()
+
+	// Act
+	result, err := dispatcher.DispatchBatch([]*order.Order{bulky}, []*courier.Courier{scooter, car})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(result.Assigned))
+	assert.Equal(t, car, result.Assigned[0].Courier, "bulky order should go by car")
+}
+
+func Test_DispatchBatchSendsBulkyOrderByOtherTransportWithoutCar(t *testing.T) {
+	// Arrange
+	location, _ := kernel.NewLocation(5, 5)
+	bulky, _ := order.NewOrder(uuid.New(), testAddress, nil, location, location, BulkyOrderVolume, 0, kernel.DeliveryPeriod{})
+	scooter, _ := courier.NewCourierOnTransport("scooter", courier.TransportScooter, 0, location)
+	_ = scooter.StartShift()
+
+	// Act
+	result, err := NewOrderDispatcherService().DispatchBatch([]*order.Order{bulky}, []*courier.Courier{scooter})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(result.Assigned), "bulky order should not wait for a car")
+}
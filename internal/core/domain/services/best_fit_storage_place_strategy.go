@@ -0,0 +1,37 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+)
+
+var _ costStrategy = &bestFitStoragePlaceStrategy{}
+
+// bestFitStoragePlaceStrategy picks the courier whose smallest fitting storage place
+// wastes the least volume, so couriers with big trunks stay free for bulky orders
+type bestFitStoragePlaceStrategy struct {
+}
+
+func NewBestFitStoragePlaceStrategy() DispatchStrategy {
+	return &bestFitStoragePlaceStrategy{}
+}
+
+func (s *bestFitStoragePlaceStrategy) Choose(order *order.Order, candidates []*courier.Courier) (*courier.Courier, error) {
+	costs, err := s.costs(order, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return chooseLowestCost(order, candidates, costs)
+}
+
+func (s *bestFitStoragePlaceStrategy) costs(order *order.Order, candidates []*courier.Courier) ([]float64, error) {
+	costs := make([]float64, len(candidates))
+	for i, c := range candidates {
+		place := c.BestFitStoragePlace(order.Requirements())
+		if place == nil {
+			return nil, ErrCourierNotFound
+		}
+		costs[i] = float64(place.TotalVolume() - order.Volume())
+	}
+	return costs, nil
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"time"
+)
+
+const (
+	DefaultTick      = time.Second
+	DefaultHoldBack  = time.Hour
+	DefaultAllowLate = true
+)
+
+// DeliveryWindowPolicy matches courier travel time, counted in ticks of courier movement,
+// against order delivery periods on the wall clock. Couriers who can not arrive in time are skipped.
+// When nobody can, the order either waits for a courier who can or, with allowLate, goes to
+// the courier who brings it first and is flagged as missing its period
+type DeliveryWindowPolicy struct {
+	tick      time.Duration
+	holdBack  time.Duration
+	allowLate bool
+	now       func() time.Time
+}
+
+// NewDeliveryWindowPolicy holds orders back until their delivery period is closer than holdBack
+func NewDeliveryWindowPolicy(
+	tick, holdBack time.Duration, allowLate bool, now func() time.Time,
+) (DeliveryWindowPolicy, error) {
+	if tick <= 0 {
+		return DeliveryWindowPolicy{}, errs.NewValueIsInvalidError("tick")
+	}
+	if holdBack < 0 {
+		return DeliveryWindowPolicy{}, errs.NewValueIsInvalidError("holdBack")
+	}
+	if now == nil {
+		return DeliveryWindowPolicy{}, errs.NewValueIsRequiredError("now")
+	}
+	return DeliveryWindowPolicy{
+		tick:      tick,
+		holdBack:  holdBack,
+		allowLate: allowLate,
+		now:       now,
+	}, nil
+}
+
+// DefaultDeliveryWindowPolicy delivers late rather than never
+func DefaultDeliveryWindowPolicy() DeliveryWindowPolicy {
+	policy, _ := NewDeliveryWindowPolicy(DefaultTick, DefaultHoldBack, DefaultAllowLate, time.Now)
+	return policy
+}
+
+// AllowsLate tells whether an order nobody can deliver in time goes to a late courier
+func (p DeliveryWindowPolicy) AllowsLate() bool {
+	return p.allowLate
+}
+
+func (p DeliveryWindowPolicy) Now() time.Time {
+	return p.now()
+}
+
+// IsDue tells whether the order should be dispatched now or held back for later
+func (p DeliveryWindowPolicy) IsDue(o *order.Order) bool {
+	period := o.DeliveryPeriod()
+	if period.IsEmpty() {
+		return true
+	}
+	return !p.now().Add(p.holdBack).Before(period.From())
+}
+
+// CanArriveInTime tells whether the courier can bring the order before its delivery period ends
+func (p DeliveryWindowPolicy) CanArriveInTime(c *courier.Courier, o *order.Order) bool {
+	period := o.DeliveryPeriod()
+	if period.IsEmpty() {
+		return true
+	}
+	ticks, err := c.CalculateTimeToDeliver(o)
+	if err != nil {
+		return false
+	}
+	arrival := p.now().Add(time.Duration(ticks) * p.tick)
+	return !arrival.After(period.To())
+}
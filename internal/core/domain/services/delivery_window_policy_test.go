@@ -0,0 +1,151 @@
+package services
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var testNow = time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+
+func newTestWindowDispatcher(t *testing.T) OrderDispatcherService {
+	return newTestWindowDispatcherAllowingLate(t, true)
+}
+
+func newTestWindowDispatcherAllowingLate(t *testing.T, allowLate bool) OrderDispatcherService {
+	window, err := NewDeliveryWindowPolicy(time.Minute, time.Hour, allowLate, func() time.Time { return testNow })
+	assert.NoError(t, err)
+	d, err := NewOrderDispatcherServiceWithStrategy(NewNearestCourierStrategy(), window)
+	assert.NoError(t, err)
+	return d
+}
+
+func newTestOrderInPeriod(x, y int, from, to time.Time) *order.Order {
+	location, _ := kernel.NewLocation(x, y)
+	period, _ := kernel.NewDeliveryPeriod(from, to)
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, location, location, kernel.MinVolume, 0, period)
+	return o
+}
+
+func Test_DispatchSkipsCourierWhoCanNotArriveInPeriod(t *testing.T) {
+	// Arrange
+	// Courier with speed 1 needs 18 minutes for the far corner, the period ends in 10
+	o := newTestOrderInPeriod(10, 10, testNow, testNow.Add(10*time.Minute))
+	slow := newTestCourierAt("slow", 1, 1)
+	fast, _ := courier.NewCourier("fast", courier.MaxSpeed, kernel.MinLocation())
+	_ = fast.StartShift()
+
+	// Act
+	c, err := newTestWindowDispatcher(t).Dispatch(o, []*courier.Courier{slow, fast})
+
+	// Assert
+	assert.NoError(t, err, "should be no error dispatching with fast courier")
+	assert.Equal(t, fast, c, "only fast courier can arrive in time")
+	assert.False(t, o.IsDeliveryPeriodMissed(), "order given to courier in time should not be flagged")
+}
+
+func Test_DispatchHoldsBackFarFutureOrder(t *testing.T) {
+	// Arrange
+	o := newTestOrderInPeriod(2, 2, testNow.Add(5*time.Hour), testNow.Add(8*time.Hour))
+	d := newTestWindowDispatcher(t)
+
+	// Act
+	c, err := d.Dispatch(o, []*courier.Courier{newTestCourierAt("any", 1, 1)})
+
+	// Assert
+	assert.False(t, d.IsDue(o), "order should be held back")
+	assert.ErrorIs(t, err, ErrOrderIsNotDue)
+	assert.Nil(t, c)
+	assert.Equal(t, order.StatusCreated, o.Status(), "held back order should stay unassigned")
+}
+
+func Test_DispatchBatchReportsHeldBackOrder(t *testing.T) {
+	// Arrange
+	later := newTestOrderInPeriod(2, 2, testNow.Add(5*time.Hour), testNow.Add(8*time.Hour))
+	soon := newTestOrderInPeriod(2, 2, testNow.Add(30*time.Minute), testNow.Add(2*time.Hour))
+	c := newTestCourierAt("any", 1, 1)
+
+	// Act
+	result, err := newTestWindowDispatcher(t).DispatchBatch([]*order.Order{later, soon}, []*courier.Courier{c})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Assigned, 1, "order with close period should be assigned")
+	assert.Equal(t, soon, result.Assigned[0].Order)
+	assert.Len(t, result.Unassigned, 1)
+	assert.ErrorIs(t, result.Unassigned[0].Reason, ErrOrderIsNotDue, "far order should be held back")
+}
+
+func Test_DispatchDeliversOrderWithExpiredPeriod(t *testing.T) {
+	// Arrange
+	// The period has ended an hour ago, nobody can arrive in time
+	o := newTestOrderInPeriod(10, 10, testNow.Add(-3*time.Hour), testNow.Add(-time.Hour))
+	far := newTestCourierAt("far", 1, 1)
+	near := newTestCourierAt("near", 9, 9)
+
+	// Act
+	c, err := newTestWindowDispatcher(t).Dispatch(o, []*courier.Courier{far, near})
+
+	// Assert
+	assert.NoError(t, err, "late order should still be delivered")
+	assert.Equal(t, near, c, "courier who arrives first should take the late order")
+	assert.Equal(t, order.StatusAssigned, o.Status())
+	assert.True(t, o.IsDeliveryPeriodMissed(), "late order should be flagged")
+}
+
+func Test_DispatchBatchDeliversOrderNobodyCanReachInTime(t *testing.T) {
+	// Arrange
+	// Courier with speed 1 needs 18 minutes for the far corner, the period ends in 10
+	o := newTestOrderInPeriod(10, 10, testNow, testNow.Add(10*time.Minute))
+	slow := newTestCourierAt("slow", 1, 1)
+
+	// Act
+	result, err := newTestWindowDispatcher(t).DispatchBatch([]*order.Order{o}, []*courier.Courier{slow})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Assigned, 1, "order should be delivered late rather than never")
+	assert.Equal(t, slow, result.Assigned[0].Courier)
+	assert.True(t, o.IsDeliveryPeriodMissed(), "order given to late courier should be flagged")
+}
+
+func Test_DispatchKeepsOrderNobodyCanReachInTimeWhenLateIsNotAllowed(t *testing.T) {
+	// Arrange
+	o := newTestOrderInPeriod(10, 10, testNow, testNow.Add(10*time.Minute))
+	slow := newTestCourierAt("slow", 1, 1)
+
+	// Act
+	c, err := newTestWindowDispatcherAllowingLate(t, false).Dispatch(o, []*courier.Courier{slow})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrCourierNotFound)
+	assert.Nil(t, c)
+	assert.Equal(t, order.StatusCreated, o.Status(), "order should wait for courier who is in time")
+	assert.False(t, o.IsDeliveryPeriodMissed())
+}
+
+func Test_DispatchBatchKeepsOrderNobodyCanReachInTimeWhenLateIsNotAllowed(t *testing.T) {
+	// Arrange
+	late := newTestOrderInPeriod(10, 10, testNow, testNow.Add(10*time.Minute))
+	onTime := newTestOrderInPeriod(2, 2, testNow, testNow.Add(10*time.Minute))
+	slow := newTestCourierAt("slow", 1, 1)
+
+	// Act
+	result, err := newTestWindowDispatcherAllowingLate(t, false).DispatchBatch(
+		[]*order.Order{late, onTime}, []*courier.Courier{slow})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Assigned, 1)
+	assert.Equal(t, onTime, result.Assigned[0].Order, "order in reach should be assigned")
+	assert.False(t, onTime.IsDeliveryPeriodMissed())
+	assert.Len(t, result.Unassigned, 1)
+	assert.Equal(t, late, result.Unassigned[0].Order)
+	assert.ErrorIs(t, result.Unassigned[0].Reason, ErrCourierNotFound, "nobody can reach the order in time")
+	assert.Equal(t, order.StatusCreated, late.Status())
+}
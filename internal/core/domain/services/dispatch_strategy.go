@@ -0,0 +1,81 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"fmt"
+	"strings"
+)
+
+const (
+	StrategyNearest     = "nearest"
+	StrategyLeastLoaded = "least_loaded"
+	StrategyRoundRobin  = "round_robin"
+	StrategyBestFit     = "best_fit"
+	StrategyWeighted    = "weighted"
+	StrategyTransport   = "transport"
+)
+
+// DispatchStrategy chooses a courier for the order. All candidates are
+// already checked to be able to take the order
+type DispatchStrategy interface {
+	Choose(order *order.Order, candidates []*courier.Courier) (*courier.Courier, error)
+}
+
+// costStrategy gives every candidate a cost, the lower the better.
+// Costs are also used by weightedStrategy to combine strategies
+type costStrategy interface {
+	DispatchStrategy
+	costs(order *order.Order, candidates []*courier.Courier) ([]float64, error)
+}
+
+// choiceObserver is implemented by strategies which keep state between dispatches
+type choiceObserver interface {
+	observe(winner *courier.Courier)
+}
+
+// NewDispatchStrategy creates a strategy by name, weights are used only by the weighted strategy
+func NewDispatchStrategy(name string, weights map[string]float64) (DispatchStrategy, error) {
+	switch strings.ToLower(name) {
+	case StrategyNearest, "":
+		return NewNearestCourierStrategy(), nil
+	case StrategyLeastLoaded:
+		return NewLeastLoadedCourierStrategy(), nil
+	case StrategyRoundRobin:
+		return NewRoundRobinStrategy(), nil
+	case StrategyBestFit:
+		return NewBestFitStoragePlaceStrategy(), nil
+	case StrategyWeighted:
+		return NewWeightedStrategy(weights)
+	case StrategyTransport:
+		return NewTransportStrategy(), nil
+	default:
+		return nil, errs.NewValueIsInvalidErrorWithCause("dispatch strategy", fmt.Errorf("unknown strategy %q", name))
+	}
+}
+
+// chooseLowestCost returns the candidate with the lowest cost,
+// ties are resolved in favour of the courier who arrives first
+func chooseLowestCost(o *order.Order, candidates []*courier.Courier, costs []float64) (*courier.Courier, error) {
+	if len(candidates) == 0 || len(candidates) != len(costs) {
+		return nil, ErrCourierNotFound
+	}
+
+	var winner *courier.Courier
+	var winnerCost, winnerTime float64
+	for i, c := range candidates {
+		time, err := c.CalculateTimeToDeliver(o)
+		if err != nil {
+			continue
+		}
+		if winner == nil || costs[i] < winnerCost || (costs[i] == winnerCost && time < winnerTime) {
+			winner, winnerCost, winnerTime = c, costs[i], time
+		}
+	}
+
+	if winner == nil {
+		return nil, ErrCourierNotFound
+	}
+	return winner, nil
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestOrder(volume int) *order.Order {
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, kernel.MinLocation(), kernel.MinLocation(), kernel.Volume(volume), 0, kernel.DeliveryPeriod{})
+	return o
+}
+
+func Test_NewDispatchStrategyUnknownName(t *testing.T) {
+	// Act
+	s, err := NewDispatchStrategy("teleport", nil)
+
+	// Assert
+	assert.Error(t, err, "should be error creating unknown strategy")
+	assert.Nil(t, s, "no strategy should be returned for unknown name")
+}
+
+func Test_NewDispatchStrategyEmptyNameIsNearest(t *testing.T) {
+	// Act
+	s, err := NewDispatchStrategy("", nil)
+
+	// Assert
+	assert.NoError(t, err, "empty name should fall back to default strategy")
+	assert.IsType(t, &nearestCourierStrategy{}, s, "default strategy should be nearest")
+}
+
+func Test_LeastLoadedStrategyPicksFreestCourier(t *testing.T) {
+	// Arrange
+	o := newTestOrder(kernel.MinVolume)
+	busy, _ := courier.NewCourier("busy", 4, kernel.MinLocation())
+	_ = busy.StartShift()
+	_ = busy.AddStoragePlace("trunk", 20)
+	_ = busy.TakeOrder(newTestOrder(kernel.MinVolume))
+	free, _ := courier.NewCourier("free", 1, kernel.MaxLocation())
+	_ = free.StartShift()
+
+	// Act
+	c, err := NewLeastLoadedCourierStrategy().Choose(o, []*courier.Courier{busy, free})
+
+	// Assert
+	assert.NoError(t, err, "should be no error choosing courier")
+	assert.Equal(t, free, c, "courier without orders should be chosen")
+}
+
+func Test_BestFitStrategyPicksSmallestFittingPlace(t *testing.T) {
+	// Arrange
+	o := newTestOrder(courier.BagVolume + 5)
+	car, _ := courier.NewCourier("car", 4, kernel.MinLocation())
+	_ = car.StartShift()
+	_ = car.AddStoragePlace("trailer", 100)
+	bike, _ := courier.NewCourier("bike", 1, kernel.MaxLocation())
+	_ = bike.StartShift()
+	_ = bike.AddStoragePlace("trunk", 20)
+
+	// Act
+	c, err := NewBestFitStoragePlaceStrategy().Choose(o, []*courier.Courier{car, bike})
+
+	// Assert
+	assert.NoError(t, err, "should be no error choosing courier")
+	assert.Equal(t, bike, c, "courier with the smallest fitting place should be chosen")
+}
+
+func Test_RoundRobinStrategyRotatesCouriers(t *testing.T) {
+	// Arrange
+	c1, _ := courier.NewCourier("one", 1, kernel.MinLocation())
+	_ = c1.StartShift()
+	c2, _ := courier.NewCourier("two", 1, kernel.MinLocation())
+	_ = c2.StartShift()
+	couriers := []*courier.Courier{c1, c2}
+	s := NewRoundRobinStrategy()
+
+	// Act
+	first, _ := s.Choose(newTestOrder(kernel.MinVolume), couriers)
+	second, _ := s.Choose(newTestOrder(kernel.MinVolume), couriers)
+	third, _ := s.Choose(newTestOrder(kernel.MinVolume), couriers)
+
+	// Assert
+	assert.NotEqual(t, first, second, "second order should go to another courier")
+	assert.Equal(t, first, third, "third order should go back to the first courier")
+}
+
+func Test_WeightedStrategyFollowsHeaviestWeight(t *testing.T) {
+	// Arrange
+	o := newTestOrder(courier.BagVolume + 5)
+	nearCar, _ := courier.NewCourier("car", 1, kernel.MinLocation())
+	_ = nearCar.StartShift()
+	_ = nearCar.AddStoragePlace("trailer", 100)
+	farBike, _ := courier.NewCourier("bike", 1, kernel.MaxLocation())
+	_ = farBike.StartShift()
+	_ = farBike.AddStoragePlace("trunk", 20)
+	couriers := []*courier.Courier{nearCar, farBike}
+
+	// Act
+	byTime, err1 := NewWeightedStrategy(map[string]float64{StrategyNearest: 0.9, StrategyBestFit: 0.1})
+	byFit, err2 := NewWeightedStrategy(map[string]float64{StrategyNearest: 0.1, StrategyBestFit: 0.9})
+	c1, _ := byTime.Choose(o, couriers)
+	c2, _ := byFit.Choose(o, couriers)
+
+	// Assert
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Equal(t, nearCar, c1, "time-heavy weights should choose the nearest courier")
+	assert.Equal(t, farBike, c2, "fit-heavy weights should choose the best fitting courier")
+}
+
+func Test_WeightedStrategyRejectsNegativeWeight(t *testing.T) {
+	// Act
+	_, err := NewWeightedStrategy(map[string]float64{StrategyNearest: -1})
+
+	// Assert
+	assert.Error(t, err, "should be error with negative weight")
+}
+
+func Test_OrderDispatcherServiceWithStrategyRequiresStrategy(t *testing.T) {
+	// Act
+	d, err := NewOrderDispatcherServiceWithStrategy(nil, DefaultDeliveryWindowPolicy())
+
+	// Assert
+	assert.Error(t, err, "should be error creating dispatcher without strategy")
+	assert.Nil(t, d)
+}
+
+func Test_TransportStrategyPrefersCarForBulkyOrder(t *testing.T) {
+	// Arrange
+	scooter, _ := courier.NewCourierOnTransport("scooter", courier.TransportScooter, courier.MaxSpeed, kernel.MinLocation())
+	_ = scooter.StartShift()
+	car, _ := courier.NewCourierOnTransport("car", courier.TransportCar, courier.MinSpeed, kernel.MaxLocation())
+	_ = car.StartShift()
+	strategy, _ := NewDispatchStrategy(StrategyTransport, nil)
+
+	// Act
+	bulky, _ := strategy.Choose(newTestOrder(BulkyOrderVolume), []*courier.Courier{scooter, car})
+	small, _ := strategy.Choose(newTestOrder(kernel.MinVolume), []*courier.Courier{scooter, car})
+
+	// Assert
+	assert.Equal(t, car, bulky, "bulky order should go by car")
+	assert.Equal(t, scooter, small, "small order should go to the courier who arrives first")
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+)
+
+var _ costStrategy = &leastLoadedCourierStrategy{}
+
+// leastLoadedCourierStrategy picks the courier with the lowest share of occupied storage places
+type leastLoadedCourierStrategy struct {
+}
+
+func NewLeastLoadedCourierStrategy() DispatchStrategy {
+	return &leastLoadedCourierStrategy{}
+}
+
+func (s *leastLoadedCourierStrategy) Choose(order *order.Order, candidates []*courier.Courier) (*courier.Courier, error) {
+	costs, err := s.costs(order, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return chooseLowestCost(order, candidates, costs)
+}
+
+func (s *leastLoadedCourierStrategy) costs(_ *order.Order, candidates []*courier.Courier) ([]float64, error) {
+	costs := make([]float64, len(candidates))
+	for i, c := range candidates {
+		costs[i] = c.Load()
+	}
+	return costs, nil
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+)
+
+var _ costStrategy = &nearestCourierStrategy{}
+
+// nearestCourierStrategy picks the courier who reaches the order location first
+type nearestCourierStrategy struct {
+}
+
+func NewNearestCourierStrategy() DispatchStrategy {
+	return &nearestCourierStrategy{}
+}
+
+func (s *nearestCourierStrategy) Choose(order *order.Order, candidates []*courier.Courier) (*courier.Courier, error) {
+	costs, err := s.costs(order, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return chooseLowestCost(order, candidates, costs)
+}
+
+func (s *nearestCourierStrategy) costs(order *order.Order, candidates []*courier.Courier) ([]float64, error) {
+	costs := make([]float64, len(candidates))
+	for i, c := range candidates {
+		time, err := c.CalculateTimeToDeliver(order)
+		if err != nil {
+			return nil, err
+		}
+		costs[i] = time
+	}
+	return costs, nil
+}
@@ -6,21 +6,59 @@ import (
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 	"errors"
+	"time"
 )
 
-var ErrCourierNotFound = errors.New("no suitable couriers for this order")
+var (
+	ErrCourierNotFound = errors.New("no suitable couriers for this order")
+	ErrOrderIsNotDue   = errors.New("order delivery period is too far away to dispatch it now")
+)
 
 type OrderDispatcherService interface {
 	Dispatch(order *order.Order, couriers []*courier.Courier) (*courier.Courier, error)
+	DispatchBatch(orders []*order.Order, couriers []*courier.Courier) (BatchDispatchResult, error)
+	// IsDue tells whether the order should be dispatched now or held back for later
+	IsDue(order *order.Order) bool
+	// Now is the moment the dispatcher checks delivery periods against
+	Now() time.Time
 }
 
 var _ OrderDispatcherService = &orderDispatcherService{}
 
 type orderDispatcherService struct {
+	strategy DispatchStrategy
+	window   DeliveryWindowPolicy
 }
 
+// NewOrderDispatcherService dispatches orders to the nearest courier
 func NewOrderDispatcherService() OrderDispatcherService {
-	return &orderDispatcherService{}
+	return &orderDispatcherService{
+		strategy: NewNearestCourierStrategy(),
+		window:   DefaultDeliveryWindowPolicy(),
+	}
+}
+
+func NewOrderDispatcherServiceWithStrategy(
+	strategy DispatchStrategy, window DeliveryWindowPolicy,
+) (OrderDispatcherService, error) {
+	if strategy == nil {
+		return nil, errs.NewValueIsRequiredError("strategy")
+	}
+	if window.now == nil {
+		return nil, errs.NewValueIsRequiredError("window")
+	}
+	return &orderDispatcherService{
+		strategy: strategy,
+		window:   window,
+	}, nil
+}
+
+func (d *orderDispatcherService) IsDue(order *order.Order) bool {
+	return d.window.IsDue(order)
+}
+
+func (d *orderDispatcherService) Now() time.Time {
+	return d.window.Now()
 }
 
 func (d *orderDispatcherService) Dispatch(order *order.Order, couriers []*courier.Courier) (*courier.Courier, error) {
@@ -30,39 +68,47 @@ func (d *orderDispatcherService) Dispatch(order *order.Order, couriers []*courie
 	if len(couriers) == 0 {
 		return nil, errs.NewValueIsRequiredError("couriers")
 	}
+	if !d.window.IsDue(order) {
+		return nil, ErrOrderIsNotDue
+	}
 
-	var bestTime float64 = -1
-	var winner *courier.Courier
-
+	candidates := make([]*courier.Courier, 0, len(couriers))
+	onTime := make([]*courier.Courier, 0, len(couriers))
 	for _, c := range couriers {
 		ok, err := c.CanTakeOrder(order)
-		if err!= nil || !ok {
+		if err != nil || !ok {
 			continue
 		}
-		time, err := c.CalculateTimeToLocation(order.Location())
-		if err != nil {
-			continue
-		}
-		if winner == nil || time < bestTime {
-			bestTime = time
-			winner = c
+		candidates = append(candidates, c)
+		if d.window.CanArriveInTime(c, order) {
+			onTime = append(onTime, c)
 		}
 	}
-
-	if winner == nil {
+	if len(candidates) == 0 {
 		return nil, ErrCourierNotFound
 	}
 
-	winnerID := winner.ID()
-	err := winner.TakeOrder(order)
-	if err != nil {
+	var winner *courier.Courier
+	var err error
+	late := len(onTime) == 0
+	if !late {
+		winner, err = d.strategy.Choose(order, onTime)
+	} else if d.window.AllowsLate() {
+		// Nobody is in time, the order is late anyway and goes to the courier who brings it first
+		winner, err = chooseLowestCost(order, candidates, make([]float64, len(candidates)))
+	}
+	if err != nil || winner == nil {
 		return nil, ErrCourierNotFound
 	}
-	err = order.Assign(&winnerID)
+
+	// TakeOrder both stores the order and assigns it to the courier
+	err = winner.TakeOrder(order)
 	if err != nil {
-		_ = winner.CompleteOrder(order)
 		return nil, ErrCourierNotFound
 	}
+	if late {
+		order.MissDeliveryPeriod()
+	}
 
 	return winner, nil
 }
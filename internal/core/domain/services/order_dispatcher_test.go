@@ -99,10 +99,13 @@ func Test_OrderDispatcherServiceErrorCourierBuisy(t *testing.T) {
 }
 
 func Test_OrderDispatcherServiceBestTime(t *testing.T) {
-	o, _ := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.Volume(kernel.MinVolume))
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, kernel.MinLocation(), kernel.MinLocation(), kernel.Volume(kernel.MinVolume), 0, kernel.DeliveryPeriod{})
 	c1, _ := courier.NewCourier("one", 1, kernel.MaxLocation())
+	_ = c1.StartShift()
 	c2, _ := courier.NewCourier("two", 2, kernel.MaxLocation())
+	_ = c2.StartShift()
 	c3, _ := courier.NewCourier("three", 4, kernel.MaxLocation())
+	_ = c3.StartShift()
 	couriers := []*courier.Courier{
 		c1, c2, c3,
 	}
@@ -116,3 +119,21 @@ func Test_OrderDispatcherServiceBestTime(t *testing.T) {
 	assert.NotEmpty(t, c, "courier should be returned when dispatching with correct params")
 	assert.Equal(t, c3, c, "fastest courier should be returned")
 }
+
+func Test_OrderDispatcherServiceSkipsOverloadedCourier(t *testing.T) {
+	// Arrange
+	o, _ := order.NewOrder(uuid.New(), testAddress, nil, kernel.MinLocation(), kernel.MinLocation(), kernel.Volume(kernel.MinVolume), 12000, kernel.DeliveryPeriod{})
+	bike, _ := courier.NewCourier("bike", 4, kernel.MinLocation())
+	_ = bike.StartShift()
+	_ = bike.ChangeMaxPayload(8000)
+	car, _ := courier.NewCourier("car", 1, kernel.MaxLocation())
+	_ = car.StartShift()
+
+	// Act
+	orderDispatcherService := NewOrderDispatcherService()
+	c, err := orderDispatcherService.Dispatch(o, []*courier.Courier{bike, car})
+
+	// Assert
+	assert.NoError(t, err, "should be no error dispatching with correct params")
+	assert.Equal(t, car, c, "courier who can carry the weight should be chosen")
+}
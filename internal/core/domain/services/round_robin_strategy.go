@@ -0,0 +1,60 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ costStrategy   = &roundRobinStrategy{}
+	_ choiceObserver = &roundRobinStrategy{}
+)
+
+// roundRobinStrategy picks the courier who has waited for an order the longest.
+// Couriers that never got an order from this instance go first
+type roundRobinStrategy struct {
+	mu           sync.Mutex
+	sequence     uint64
+	lastAssigned map[uuid.UUID]uint64
+}
+
+func NewRoundRobinStrategy() DispatchStrategy {
+	return &roundRobinStrategy{
+		lastAssigned: make(map[uuid.UUID]uint64),
+	}
+}
+
+func (s *roundRobinStrategy) Choose(order *order.Order, candidates []*courier.Courier) (*courier.Courier, error) {
+	costs, err := s.costs(order, candidates)
+	if err != nil {
+		return nil, err
+	}
+	winner, err := chooseLowestCost(order, candidates, costs)
+	if err != nil {
+		return nil, err
+	}
+	s.observe(winner)
+	return winner, nil
+}
+
+func (s *roundRobinStrategy) costs(_ *order.Order, candidates []*courier.Courier) ([]float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	costs := make([]float64, len(candidates))
+	for i, c := range candidates {
+		costs[i] = float64(s.lastAssigned[c.ID()])
+	}
+	return costs, nil
+}
+
+func (s *roundRobinStrategy) observe(winner *courier.Courier) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sequence++
+	s.lastAssigned[winner.ID()] = s.sequence
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"delivery/internal/core/domain/kernel"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+)
+
+const (
+	// BulkyOrderVolume is more than a courier bag takes
+	BulkyOrderVolume = courier.BagVolume + 1
+	// BulkyOrderWeight is more than a bicycle courier lifts
+	BulkyOrderWeight kernel.Weight = 10001
+)
+
+var _ costStrategy = &transportStrategy{}
+
+// transportStrategy picks a car for bulky orders, other orders are left to the rest of the strategies
+type transportStrategy struct {
+}
+
+func NewTransportStrategy() DispatchStrategy {
+	return &transportStrategy{}
+}
+
+func (s *transportStrategy) Choose(order *order.Order, candidates []*courier.Courier) (*courier.Courier, error) {
+	costs, err := s.costs(order, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return chooseLowestCost(order, candidates, costs)
+}
+
+func (s *transportStrategy) costs(order *order.Order, candidates []*courier.Courier) ([]float64, error) {
+	costs := make([]float64, len(candidates))
+	for i, c := range candidates {
+		if isWrongTransport(order, c) {
+			costs[i] = 1
+		}
+	}
+	return costs, nil
+}
+
+// isWrongTransport tells that the order is bulky and the courier does not go by car
+func isWrongTransport(order *order.Order, c *courier.Courier) bool {
+	return isBulky(order) && c.Transport() != courier.TransportCar
+}
+
+func isBulky(order *order.Order) bool {
+	return order.Volume() >= BulkyOrderVolume || order.Weight() >= BulkyOrderWeight
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"fmt"
+	"sort"
+)
+
+var _ DispatchStrategy = &weightedStrategy{}
+
+type weightedComponent struct {
+	strategy costStrategy
+	weight   float64
+}
+
+// weightedStrategy sums costs of other strategies, each normalized
+// to the 0..1 range over the candidates and multiplied by its weight
+type weightedStrategy struct {
+	components []weightedComponent
+}
+
+// DefaultDispatchWeights are used by the weighted strategy when no weights are configured
+var DefaultDispatchWeights = map[string]float64{
+	StrategyNearest:     0.6,
+	StrategyLeastLoaded: 0.2,
+	StrategyBestFit:     0.2,
+}
+
+func NewWeightedStrategy(weights map[string]float64) (DispatchStrategy, error) {
+	if len(weights) == 0 {
+		weights = DefaultDispatchWeights
+	}
+
+	// sorted to make the result independent of map iteration order
+	names := make([]string, 0, len(weights))
+	for name := range weights {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	components := make([]weightedComponent, 0, len(weights))
+	for _, name := range names {
+		weight := weights[name]
+		if weight < 0 {
+			return nil, errs.NewValueIsOutOfRangeError("weight of "+name, weight, 0, "+Inf")
+		}
+		if name == StrategyWeighted {
+			return nil, errs.NewValueIsInvalidErrorWithCause("dispatch weights", fmt.Errorf("weighted strategy can not include itself"))
+		}
+		strategy, err := NewDispatchStrategy(name, nil)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, weightedComponent{
+			strategy: strategy.(costStrategy),
+			weight:   weight,
+		})
+	}
+
+	return &weightedStrategy{components: components}, nil
+}
+
+func (s *weightedStrategy) Choose(order *order.Order, candidates []*courier.Courier) (*courier.Courier, error) {
+	total := make([]float64, len(candidates))
+	for _, component := range s.components {
+		costs, err := component.strategy.costs(order, candidates)
+		if err != nil {
+			return nil, err
+		}
+		for i, cost := range normalize(costs) {
+			total[i] += component.weight * cost
+		}
+	}
+
+	winner, err := chooseLowestCost(order, candidates, total)
+	if err != nil {
+		return nil, err
+	}
+	for _, component := range s.components {
+		if observer, ok := component.strategy.(choiceObserver); ok {
+			observer.observe(winner)
+		}
+	}
+	return winner, nil
+}
+
+func normalize(values []float64) []float64 {
+	normalized := make([]float64, len(values))
+	if len(values) == 0 {
+		return normalized
+	}
+	lowest, highest := values[0], values[0]
+	for _, v := range values {
+		lowest = min(lowest, v)
+		highest = max(highest, v)
+	}
+	if highest == lowest {
+		return normalized
+	}
+	for i, v := range values {
+		normalized[i] = (v - lowest) / (highest - lowest)
+	}
+	return normalized
+}
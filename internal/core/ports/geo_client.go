@@ -6,5 +6,5 @@ import (
 )
 
 type GeoClient interface{
-	GetGeoLocation(ctx context.Context, street string) (kernel.Location, error)
+	GetGeoLocation(ctx context.Context, address kernel.Address) (kernel.Location, error)
 }
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/google/uuid"
+)
+
+// HandoverClient tells whether the recipient has accepted the parcel at drop-off.
+// It returns order.FailureReasonNone when the parcel is handed over
+type HandoverClient interface {
+	HandOver(ctx context.Context, orderID uuid.UUID) (order.FailureReason, error)
+}
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+	"delivery/internal/pkg/outbox"
+)
+
+type OutboxRepository interface {
+	GetNotPublishedMessages(ctx context.Context) ([]*outbox.Message, error)
+	Update(ctx context.Context, message *outbox.Message) error
+}
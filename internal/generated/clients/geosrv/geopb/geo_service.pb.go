@@ -25,6 +25,9 @@ const (
 type GetGeolocationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Street        string                 `protobuf:"bytes,1,opt,name=Street,proto3" json:"Street,omitempty"`
+	Country       string                 `protobuf:"bytes,2,opt,name=Country,proto3" json:"Country,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=City,proto3" json:"City,omitempty"`
+	House         string                 `protobuf:"bytes,4,opt,name=House,proto3" json:"House,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,6 +69,27 @@ func (x *GetGeolocationRequest) GetStreet() string {
 	return ""
 }
 
+func (x *GetGeolocationRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *GetGeolocationRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *GetGeolocationRequest) GetHouse() string {
+	if x != nil {
+		return x.House
+	}
+	return ""
+}
+
 // Response
 type GetGeolocationReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -212,9 +236,12 @@ var File_api_proto_geo_service_proto protoreflect.FileDescriptor
 
 const file_api_proto_geo_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1bapi/proto/geo_service.proto\x12\x03geo\"/\n" +
+	"\x1bapi/proto/geo_service.proto\x12\x03geo\"s\n" +
 	"\x15GetGeolocationRequest\x12\x16\n" +
-	"\x06Street\x18\x01 \x01(\tR\x06Street\"@\n" +
+	"\x06Street\x18\x01 \x01(\tR\x06Street\x12\x18\n" +
+	"\aCountry\x18\x02 \x01(\tR\aCountry\x12\x12\n" +
+	"\x04City\x18\x03 \x01(\tR\x04City\x12\x14\n" +
+	"\x05House\x18\x04 \x01(\tR\x05House\"@\n" +
 	"\x13GetGeolocationReply\x12)\n" +
 	"\bLocation\x18\x01 \x01(\v2\r.geo.LocationR\bLocation\"&\n" +
 	"\bLocation\x12\f\n" +
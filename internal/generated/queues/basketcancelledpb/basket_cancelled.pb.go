@@ -0,0 +1,131 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: api/proto/basket_cancelled.proto
+
+package basketcancelledpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BasketCancelledIntegrationEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BasketId      string                 `protobuf:"bytes,1,opt,name=basketId,proto3" json:"basketId,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BasketCancelledIntegrationEvent) Reset() {
+	*x = BasketCancelledIntegrationEvent{}
+	mi := &file_api_proto_basket_cancelled_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BasketCancelledIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketCancelledIntegrationEvent) ProtoMessage() {}
+
+func (x *BasketCancelledIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_basket_cancelled_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasketCancelledIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*BasketCancelledIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_basket_cancelled_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BasketCancelledIntegrationEvent) GetBasketId() string {
+	if x != nil {
+		return x.BasketId
+	}
+	return ""
+}
+
+func (x *BasketCancelledIntegrationEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_api_proto_basket_cancelled_proto protoreflect.FileDescriptor
+
+const file_api_proto_basket_cancelled_proto_rawDesc = "" +
+	"\n" +
+	" api/proto/basket_cancelled.proto\x12\x0fBasketCancelled\"U\n" +
+	"\x1fBasketCancelledIntegrationEvent\x12\x1a\n" +
+	"\bbasketId\x18\x01 \x01(\tR\bbasketId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reasonB\x1aZ\x18queues/basketcancelledpbb\x06proto3"
+
+var (
+	file_api_proto_basket_cancelled_proto_rawDescOnce sync.Once
+	file_api_proto_basket_cancelled_proto_rawDescData []byte
+)
+
+func file_api_proto_basket_cancelled_proto_rawDescGZIP() []byte {
+	file_api_proto_basket_cancelled_proto_rawDescOnce.Do(func() {
+		file_api_proto_basket_cancelled_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_basket_cancelled_proto_rawDesc), len(file_api_proto_basket_cancelled_proto_rawDesc)))
+	})
+	return file_api_proto_basket_cancelled_proto_rawDescData
+}
+
+var file_api_proto_basket_cancelled_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_proto_basket_cancelled_proto_goTypes = []any{
+	(*BasketCancelledIntegrationEvent)(nil), // 0: BasketCancelled.BasketCancelledIntegrationEvent
+}
+var file_api_proto_basket_cancelled_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_basket_cancelled_proto_init() }
+func file_api_proto_basket_cancelled_proto_init() {
+	if File_api_proto_basket_cancelled_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_basket_cancelled_proto_rawDesc), len(file_api_proto_basket_cancelled_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_basket_cancelled_proto_goTypes,
+		DependencyIndexes: file_api_proto_basket_cancelled_proto_depIdxs,
+		MessageInfos:      file_api_proto_basket_cancelled_proto_msgTypes,
+	}.Build()
+	File_api_proto_basket_cancelled_proto = out.File
+	file_api_proto_basket_cancelled_proto_goTypes = nil
+	file_api_proto_basket_cancelled_proto_depIdxs = nil
+}
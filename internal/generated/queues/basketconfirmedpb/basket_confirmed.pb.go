@@ -28,6 +28,7 @@ type BasketConfirmedIntegrationEvent struct {
 	Items          []*Item                `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 	DeliveryPeriod *DeliveryPeriod        `protobuf:"bytes,4,opt,name=deliveryPeriod,proto3" json:"deliveryPeriod,omitempty"`
 	Volume         int32                  `protobuf:"varint,5,opt,name=Volume,proto3" json:"Volume,omitempty"`
+	Weight         int32                  `protobuf:"varint,6,opt,name=Weight,proto3" json:"Weight,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -97,6 +98,13 @@ func (x *BasketConfirmedIntegrationEvent) GetVolume() int32 {
 	return 0
 }
 
+func (x *BasketConfirmedIntegrationEvent) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
 type Address struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
@@ -180,6 +188,9 @@ type Item struct {
 	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
 	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Frozen        bool                   `protobuf:"varint,6,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	Fragile       bool                   `protobuf:"varint,7,opt,name=fragile,proto3" json:"fragile,omitempty"`
+	Weight        int32                  `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -249,6 +260,27 @@ func (x *Item) GetQuantity() int32 {
 	return 0
 }
 
+func (x *Item) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *Item) GetFragile() bool {
+	if x != nil {
+		return x.Fragile
+	}
+	return false
+}
+
+func (x *Item) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
 type DeliveryPeriod struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	From          int32                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
@@ -305,25 +337,29 @@ var File_api_proto_basket_confirmed_proto protoreflect.FileDescriptor
 
 const file_api_proto_basket_confirmed_proto_rawDesc = "" +
 	"\n" +
-	" api/proto/basket_confirmed.proto\x12\x0fBasketConfirmed\"\xff\x01\n" +
+	" api/proto/basket_confirmed.proto\x12\x0fBasketConfirmed\"\x97\x02\n" +
 	"\x1fBasketConfirmedIntegrationEvent\x12\x1a\n" +
 	"\bbasketId\x18\x01 \x01(\tR\bbasketId\x122\n" +
 	"\aaddress\x18\x02 \x01(\v2\x18.BasketConfirmed.AddressR\aaddress\x12+\n" +
 	"\x05items\x18\x03 \x03(\v2\x15.BasketConfirmed.ItemR\x05items\x12G\n" +
 	"\x0edeliveryPeriod\x18\x04 \x01(\v2\x1f.BasketConfirmed.DeliveryPeriodR\x0edeliveryPeriod\x12\x16\n" +
-	"\x06Volume\x18\x05 \x01(\x05R\x06Volume\"\x83\x01\n" +
+	"\x06Volume\x18\x05 \x01(\x05R\x06Volume\x12\x16\n" +
+	"\x06Weight\x18\x06 \x01(\x05R\x06Weight\"\x83\x01\n" +
 	"\aAddress\x12\x18\n" +
 	"\acountry\x18\x01 \x01(\tR\acountry\x12\x12\n" +
 	"\x04city\x18\x02 \x01(\tR\x04city\x12\x16\n" +
 	"\x06street\x18\x03 \x01(\tR\x06street\x12\x14\n" +
 	"\x05house\x18\x04 \x01(\tR\x05house\x12\x1c\n" +
-	"\tapartment\x18\x05 \x01(\tR\tapartment\"v\n" +
+	"\tapartment\x18\x05 \x01(\tR\tapartment\"\xc0\x01\n" +
 	"\x04Item\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06goodId\x18\x02 \x01(\tR\x06goodId\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x05 \x01(\x05R\bquantity\"4\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06frozen\x18\x06 \x01(\bR\x06frozen\x12\x18\n" +
+	"\afragile\x18\a \x01(\bR\afragile\x12\x16\n" +
+	"\x06weight\x18\b \x01(\x05R\x06weight\"4\n" +
 	"\x0eDeliveryPeriod\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\x05R\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\x05R\x02toB\x1aZ\x18queues/basketconfirmedpbb\x06proto3"
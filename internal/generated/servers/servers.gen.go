@@ -17,10 +17,45 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CourierStatus.
+const (
+	Deactivated CourierStatus = "Deactivated"
+	OffDuty     CourierStatus = "OffDuty"
+	OnBreak     CourierStatus = "OnBreak"
+	OnShift     CourierStatus = "OnShift"
+)
+
+// Defines values for Transport.
+const (
+	Bicycle    Transport = "Bicycle"
+	Car        Transport = "Car"
+	Pedestrian Transport = "Pedestrian"
+	Scooter    Transport = "Scooter"
+)
+
+// Address defines model for Address.
+type Address struct {
+	// Apartment Квартира
+	Apartment string `json:"apartment"`
+
+	// City Город
+	City string `json:"city"`
+
+	// Country Страна
+	Country string `json:"country"`
+
+	// House Дом
+	House string `json:"house"`
+
+	// Street Улица
+	Street string `json:"street"`
+}
+
 // Courier defines model for Courier.
 type Courier struct {
 	// Id Идентификатор
@@ -29,8 +64,45 @@ type Courier struct {
 
 	// Name Имя
 	Name string `json:"name"`
+
+	// Status Статус курьера
+	Status CourierStatus `json:"status"`
+
+	// Transport Транспорт курьера, задает скорость, места хранения и зоны доставки
+	Transport Transport `json:"transport"`
+}
+
+// CourierDetails defines model for CourierDetails.
+type CourierDetails struct {
+	// Id Идентификатор
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+
+	// MaxPayload Максимальный вес заказов в граммах, 0 - без ограничения
+	MaxPayload int `json:"maxPayload"`
+
+	// Name Имя
+	Name string `json:"name"`
+
+	// Payload Вес заказов в местах хранения в граммах
+	Payload int `json:"payload"`
+
+	// Speed Скорость
+	Speed int `json:"speed"`
+
+	// Status Статус курьера
+	Status CourierStatus `json:"status"`
+
+	// StoragePlaces Места хранения
+	StoragePlaces []StoragePlace `json:"storagePlaces"`
+
+	// Transport Транспорт курьера, задает скорость, места хранения и зоны доставки
+	Transport Transport `json:"transport"`
 }
 
+// CourierStatus Статус курьера
+type CourierStatus string
+
 // Error defines model for Error.
 type Error struct {
 	// Code Код ошибки
@@ -42,6 +114,12 @@ type Error struct {
 
 // Location defines model for Location.
 type Location struct {
+	// Latitude Широта WGS84, только в режиме реальных координат
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Longitude Долгота WGS84, только в режиме реальных координат
+	Longitude *float64 `json:"longitude,omitempty"`
+
 	// X X
 	X int `json:"x"`
 
@@ -51,23 +129,142 @@ type Location struct {
 
 // NewCourier defines model for NewCourier.
 type NewCourier struct {
+	// MaxPayload Максимальный вес заказов в граммах, 0 - без ограничения, по умолчанию зависит от транспорта
+	MaxPayload *int `json:"maxPayload,omitempty"`
+
 	// Name Имя
 	Name string `json:"name"`
 
-	// Speed Скорость
-	Speed int `json:"speed"`
+	// Speed Скорость, по умолчанию зависит от транспорта
+	Speed *int `json:"speed,omitempty"`
+
+	// Transport Транспорт курьера, задает скорость, места хранения и зоны доставки
+	Transport *Transport `json:"transport,omitempty"`
+}
+
+// NewStoragePlace defines model for NewStoragePlace.
+type NewStoragePlace struct {
+	// FragileSafe Подходит ли для хрупких товаров
+	FragileSafe *bool `json:"fragileSafe,omitempty"`
+
+	// MaxWeight Максимальный вес в граммах, 0 - без ограничения
+	MaxWeight *int `json:"maxWeight,omitempty"`
+
+	// Name Название
+	Name string `json:"name"`
+
+	// Refrigerated Есть ли охлаждение
+	Refrigerated *bool `json:"refrigerated,omitempty"`
+
+	// TotalVolume Объем
+	TotalVolume int `json:"totalVolume"`
 }
 
 // Order defines model for Order.
 type Order struct {
+	Address Address `json:"address"`
+
 	// Id Идентификатор
 	Id       openapi_types.UUID `json:"id"`
 	Location Location           `json:"location"`
 }
 
+// OrderDetails defines model for OrderDetails.
+type OrderDetails struct {
+	Address Address `json:"address"`
+
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// Items Состав заказа
+	Items    []OrderItem `json:"items"`
+	Location Location    `json:"location"`
+
+	// Status Статус
+	Status string `json:"status"`
+
+	// TotalPrice Объявленная ценность в копейках
+	TotalPrice int64 `json:"totalPrice"`
+
+	// Weight Вес в граммах, 0 - неизвестен
+	Weight int `json:"weight"`
+}
+
+// OrderItem defines model for OrderItem.
+type OrderItem struct {
+	// GoodId Идентификатор товара
+	GoodId openapi_types.UUID `json:"goodId"`
+
+	// Price Цена за единицу в копейках
+	Price int64 `json:"price"`
+
+	// Quantity Количество
+	Quantity int `json:"quantity"`
+
+	// Title Наименование
+	Title string `json:"title"`
+}
+
+// ResizeStoragePlace defines model for ResizeStoragePlace.
+type ResizeStoragePlace struct {
+	// TotalVolume Объем
+	TotalVolume int `json:"totalVolume"`
+}
+
+// StoragePlace defines model for StoragePlace.
+type StoragePlace struct {
+	// FragileSafe Подходит ли для хрупких товаров
+	FragileSafe bool `json:"fragileSafe"`
+
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// MaxWeight Максимальный вес в граммах, 0 - без ограничения
+	MaxWeight int `json:"maxWeight"`
+
+	// Name Название
+	Name string `json:"name"`
+
+	// Occupied Занято ли место заказом
+	Occupied bool `json:"occupied"`
+
+	// OrderId Идентификатор заказа в месте хранения
+	OrderId *openapi_types.UUID `json:"orderId,omitempty"`
+
+	// Refrigerated Есть ли охлаждение
+	Refrigerated bool `json:"refrigerated"`
+
+	// TotalVolume Объем
+	TotalVolume int `json:"totalVolume"`
+}
+
+// Transport Транспорт курьера, задает скорость, места хранения и зоны доставки
+type Transport string
+
+// UpdateCourier defines model for UpdateCourier.
+type UpdateCourier struct {
+	// MaxPayload Максимальный вес заказов в граммах, 0 - без ограничения
+	MaxPayload *int `json:"maxPayload,omitempty"`
+
+	// Name Имя
+	Name *string `json:"name,omitempty"`
+
+	// Speed Скорость
+	Speed *int `json:"speed,omitempty"`
+}
+
 // CreateCourierJSONRequestBody defines body for CreateCourier for application/json ContentType.
 type CreateCourierJSONRequestBody = NewCourier
 
+// UpdateCourierJSONRequestBody defines body for UpdateCourier for application/json ContentType.
+type UpdateCourierJSONRequestBody = UpdateCourier
+
+// AddStoragePlaceJSONRequestBody defines body for AddStoragePlace for application/json ContentType.
+type AddStoragePlaceJSONRequestBody = NewStoragePlace
+
+// ResizeStoragePlaceJSONRequestBody defines body for ResizeStoragePlace for application/json ContentType.
+type ResizeStoragePlaceJSONRequestBody = ResizeStoragePlace
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Получить всех курьеров
@@ -76,12 +273,42 @@ type ServerInterface interface {
 	// Добавить курьера
 	// (POST /api/v1/couriers)
 	CreateCourier(ctx echo.Context) error
+	// Удалить курьера
+	// (DELETE /api/v1/couriers/{courierId})
+	DeleteCourier(ctx echo.Context, courierId openapi_types.UUID) error
+	// Получить курьера
+	// (GET /api/v1/couriers/{courierId})
+	GetCourier(ctx echo.Context, courierId openapi_types.UUID) error
+	// Изменить курьера
+	// (PATCH /api/v1/couriers/{courierId})
+	UpdateCourier(ctx echo.Context, courierId openapi_types.UUID) error
+	// Завершить смену
+	// (POST /api/v1/couriers/{courierId}/end-shift)
+	EndShift(ctx echo.Context, courierId openapi_types.UUID) error
+	// Начать смену
+	// (POST /api/v1/couriers/{courierId}/start-shift)
+	StartShift(ctx echo.Context, courierId openapi_types.UUID) error
+	// Добавить место хранения
+	// (POST /api/v1/couriers/{courierId}/storage-places)
+	AddStoragePlace(ctx echo.Context, courierId openapi_types.UUID) error
+	// Удалить место хранения
+	// (DELETE /api/v1/couriers/{courierId}/storage-places/{storagePlaceId})
+	RemoveStoragePlace(ctx echo.Context, courierId openapi_types.UUID, storagePlaceId openapi_types.UUID) error
+	// Изменить объем места хранения
+	// (PATCH /api/v1/couriers/{courierId}/storage-places/{storagePlaceId})
+	ResizeStoragePlace(ctx echo.Context, courierId openapi_types.UUID, storagePlaceId openapi_types.UUID) error
 	// Создать заказ
 	// (POST /api/v1/orders)
 	CreateOrder(ctx echo.Context) error
 	// Получить все незавершенные заказы
 	// (GET /api/v1/orders/active)
 	GetOrders(ctx echo.Context) error
+	// Получить заказ
+	// (GET /api/v1/orders/{orderId})
+	GetOrder(ctx echo.Context, orderId openapi_types.UUID) error
+	// Отменить заказ
+	// (POST /api/v1/orders/{orderId}/cancel)
+	CancelOrder(ctx echo.Context, orderId openapi_types.UUID) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -107,6 +334,150 @@ func (w *ServerInterfaceWrapper) CreateCourier(ctx echo.Context) error {
 	return err
 }
 
+// DeleteCourier converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCourier(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCourier(ctx, courierId)
+	return err
+}
+
+// GetCourier converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCourier(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCourier(ctx, courierId)
+	return err
+}
+
+// UpdateCourier converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCourier(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCourier(ctx, courierId)
+	return err
+}
+
+// EndShift converts echo context to params.
+func (w *ServerInterfaceWrapper) EndShift(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EndShift(ctx, courierId)
+	return err
+}
+
+// StartShift converts echo context to params.
+func (w *ServerInterfaceWrapper) StartShift(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartShift(ctx, courierId)
+	return err
+}
+
+// AddStoragePlace converts echo context to params.
+func (w *ServerInterfaceWrapper) AddStoragePlace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddStoragePlace(ctx, courierId)
+	return err
+}
+
+// RemoveStoragePlace converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveStoragePlace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Path parameter "storagePlaceId" -------------
+	var storagePlaceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "storagePlaceId", ctx.Param("storagePlaceId"), &storagePlaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter storagePlaceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveStoragePlace(ctx, courierId, storagePlaceId)
+	return err
+}
+
+// ResizeStoragePlace converts echo context to params.
+func (w *ServerInterfaceWrapper) ResizeStoragePlace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Path parameter "storagePlaceId" -------------
+	var storagePlaceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "storagePlaceId", ctx.Param("storagePlaceId"), &storagePlaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter storagePlaceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResizeStoragePlace(ctx, courierId, storagePlaceId)
+	return err
+}
+
 // CreateOrder converts echo context to params.
 func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
 	var err error
@@ -125,6 +496,38 @@ func (w *ServerInterfaceWrapper) GetOrders(ctx echo.Context) error {
 	return err
 }
 
+// GetOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrder(ctx, orderId)
+	return err
+}
+
+// CancelOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelOrder(ctx, orderId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -155,8 +558,18 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/api/v1/couriers", wrapper.GetCouriers)
 	router.POST(baseURL+"/api/v1/couriers", wrapper.CreateCourier)
+	router.DELETE(baseURL+"/api/v1/couriers/:courierId", wrapper.DeleteCourier)
+	router.GET(baseURL+"/api/v1/couriers/:courierId", wrapper.GetCourier)
+	router.PATCH(baseURL+"/api/v1/couriers/:courierId", wrapper.UpdateCourier)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/end-shift", wrapper.EndShift)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/start-shift", wrapper.StartShift)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/storage-places", wrapper.AddStoragePlace)
+	router.DELETE(baseURL+"/api/v1/couriers/:courierId/storage-places/:storagePlaceId", wrapper.RemoveStoragePlace)
+	router.PATCH(baseURL+"/api/v1/couriers/:courierId/storage-places/:storagePlaceId", wrapper.ResizeStoragePlace)
 	router.POST(baseURL+"/api/v1/orders", wrapper.CreateOrder)
 	router.GET(baseURL+"/api/v1/orders/active", wrapper.GetOrders)
+	router.GET(baseURL+"/api/v1/orders/:orderId", wrapper.GetOrder)
+	router.POST(baseURL+"/api/v1/orders/:orderId/cancel", wrapper.CancelOrder)
 
 }
 
@@ -234,101 +647,652 @@ func (response CreateCourierdefaultJSONResponse) VisitCreateCourierResponse(w ht
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateOrderRequestObject struct {
+type DeleteCourierRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
 }
 
-type CreateOrderResponseObject interface {
-	VisitCreateOrderResponse(w http.ResponseWriter) error
+type DeleteCourierResponseObject interface {
+	VisitDeleteCourierResponse(w http.ResponseWriter) error
 }
 
-type CreateOrder201Response struct {
+type DeleteCourier204Response struct {
 }
 
-func (response CreateOrder201Response) VisitCreateOrderResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
+func (response DeleteCourier204Response) VisitDeleteCourierResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
 	return nil
 }
 
-type CreateOrderdefaultJSONResponse struct {
+type DeleteCourier400JSONResponse Error
+
+func (response DeleteCourier400JSONResponse) VisitDeleteCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCourier404JSONResponse Error
+
+func (response DeleteCourier404JSONResponse) VisitDeleteCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCourier409JSONResponse Error
+
+func (response DeleteCourier409JSONResponse) VisitDeleteCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCourierdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response CreateOrderdefaultJSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+func (response DeleteCourierdefaultJSONResponse) VisitDeleteCourierResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetOrdersRequestObject struct {
+type GetCourierRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
 }
 
-type GetOrdersResponseObject interface {
-	VisitGetOrdersResponse(w http.ResponseWriter) error
+type GetCourierResponseObject interface {
+	VisitGetCourierResponse(w http.ResponseWriter) error
 }
 
-type GetOrders200JSONResponse []Order
+type GetCourier200JSONResponse CourierDetails
 
-func (response GetOrders200JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+func (response GetCourier200JSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOrdersdefaultJSONResponse struct {
+type GetCourier404JSONResponse Error
+
+func (response GetCourier404JSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+func (response GetCourierdefaultJSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получить всех курьеров
-	// (GET /api/v1/couriers)
-	GetCouriers(ctx context.Context, request GetCouriersRequestObject) (GetCouriersResponseObject, error)
-	// Добавить курьера
-	// (POST /api/v1/couriers)
-	CreateCourier(ctx context.Context, request CreateCourierRequestObject) (CreateCourierResponseObject, error)
-	// Создать заказ
-	// (POST /api/v1/orders)
-	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
-	// Получить все незавершенные заказы
-	// (GET /api/v1/orders/active)
-	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
+type UpdateCourierRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *UpdateCourierJSONRequestBody
 }
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+type UpdateCourierResponseObject interface {
+	VisitUpdateCourierResponse(w http.ResponseWriter) error
+}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+type UpdateCourier200Response struct {
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+func (response UpdateCourier200Response) VisitUpdateCourierResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-// GetCouriers operation middleware
-func (sh *strictHandler) GetCouriers(ctx echo.Context) error {
-	var request GetCouriersRequestObject
+type UpdateCourier400JSONResponse Error
 
-	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCouriers(ctx.Request().Context(), request.(GetCouriersRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCouriers")
-	}
+func (response UpdateCourier400JSONResponse) VisitUpdateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	response, err := handler(ctx, request)
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCourier404JSONResponse Error
+
+func (response UpdateCourier404JSONResponse) VisitUpdateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCourier409JSONResponse Error
+
+func (response UpdateCourier409JSONResponse) VisitUpdateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCourierdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response UpdateCourierdefaultJSONResponse) VisitUpdateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type EndShiftRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type EndShiftResponseObject interface {
+	VisitEndShiftResponse(w http.ResponseWriter) error
+}
+
+type EndShift200Response struct {
+}
+
+func (response EndShift200Response) VisitEndShiftResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type EndShift400JSONResponse Error
+
+func (response EndShift400JSONResponse) VisitEndShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndShift404JSONResponse Error
+
+func (response EndShift404JSONResponse) VisitEndShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndShift409JSONResponse Error
+
+func (response EndShift409JSONResponse) VisitEndShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndShiftdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response EndShiftdefaultJSONResponse) VisitEndShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StartShiftRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type StartShiftResponseObject interface {
+	VisitStartShiftResponse(w http.ResponseWriter) error
+}
+
+type StartShift200Response struct {
+}
+
+func (response StartShift200Response) VisitStartShiftResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type StartShift400JSONResponse Error
+
+func (response StartShift400JSONResponse) VisitStartShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartShift404JSONResponse Error
+
+func (response StartShift404JSONResponse) VisitStartShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartShift409JSONResponse Error
+
+func (response StartShift409JSONResponse) VisitStartShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartShiftdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StartShiftdefaultJSONResponse) VisitStartShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddStoragePlaceRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *AddStoragePlaceJSONRequestBody
+}
+
+type AddStoragePlaceResponseObject interface {
+	VisitAddStoragePlaceResponse(w http.ResponseWriter) error
+}
+
+type AddStoragePlace201Response struct {
+}
+
+func (response AddStoragePlace201Response) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type AddStoragePlace400JSONResponse Error
+
+func (response AddStoragePlace400JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlace404JSONResponse Error
+
+func (response AddStoragePlace404JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlace409JSONResponse Error
+
+func (response AddStoragePlace409JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlacedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response AddStoragePlacedefaultJSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RemoveStoragePlaceRequestObject struct {
+	CourierId      openapi_types.UUID `json:"courierId"`
+	StoragePlaceId openapi_types.UUID `json:"storagePlaceId"`
+}
+
+type RemoveStoragePlaceResponseObject interface {
+	VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error
+}
+
+type RemoveStoragePlace204Response struct {
+}
+
+func (response RemoveStoragePlace204Response) VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveStoragePlace400JSONResponse Error
+
+func (response RemoveStoragePlace400JSONResponse) VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveStoragePlace404JSONResponse Error
+
+func (response RemoveStoragePlace404JSONResponse) VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveStoragePlace409JSONResponse Error
+
+func (response RemoveStoragePlace409JSONResponse) VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveStoragePlacedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RemoveStoragePlacedefaultJSONResponse) VisitRemoveStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ResizeStoragePlaceRequestObject struct {
+	CourierId      openapi_types.UUID `json:"courierId"`
+	StoragePlaceId openapi_types.UUID `json:"storagePlaceId"`
+	Body           *ResizeStoragePlaceJSONRequestBody
+}
+
+type ResizeStoragePlaceResponseObject interface {
+	VisitResizeStoragePlaceResponse(w http.ResponseWriter) error
+}
+
+type ResizeStoragePlace200Response struct {
+}
+
+func (response ResizeStoragePlace200Response) VisitResizeStoragePlaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ResizeStoragePlace400JSONResponse Error
+
+func (response ResizeStoragePlace400JSONResponse) VisitResizeStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResizeStoragePlace404JSONResponse Error
+
+func (response ResizeStoragePlace404JSONResponse) VisitResizeStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResizeStoragePlace409JSONResponse Error
+
+func (response ResizeStoragePlace409JSONResponse) VisitResizeStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResizeStoragePlacedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ResizeStoragePlacedefaultJSONResponse) VisitResizeStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOrderRequestObject struct {
+}
+
+type CreateOrderResponseObject interface {
+	VisitCreateOrderResponse(w http.ResponseWriter) error
+}
+
+type CreateOrder201Response struct {
+}
+
+func (response CreateOrder201Response) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type CreateOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateOrderdefaultJSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrdersRequestObject struct {
+}
+
+type GetOrdersResponseObject interface {
+	VisitGetOrdersResponse(w http.ResponseWriter) error
+}
+
+type GetOrders200JSONResponse []Order
+
+func (response GetOrders200JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrdersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type GetOrderResponseObject interface {
+	VisitGetOrderResponse(w http.ResponseWriter) error
+}
+
+type GetOrder200JSONResponse OrderDetails
+
+func (response GetOrder200JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder404JSONResponse Error
+
+func (response GetOrder404JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderdefaultJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type CancelOrderResponseObject interface {
+	VisitCancelOrderResponse(w http.ResponseWriter) error
+}
+
+type CancelOrder200Response struct {
+}
+
+func (response CancelOrder200Response) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CancelOrder400JSONResponse Error
+
+func (response CancelOrder400JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder404JSONResponse Error
+
+func (response CancelOrder404JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder409JSONResponse Error
+
+func (response CancelOrder409JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CancelOrderdefaultJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Получить всех курьеров
+	// (GET /api/v1/couriers)
+	GetCouriers(ctx context.Context, request GetCouriersRequestObject) (GetCouriersResponseObject, error)
+	// Добавить курьера
+	// (POST /api/v1/couriers)
+	CreateCourier(ctx context.Context, request CreateCourierRequestObject) (CreateCourierResponseObject, error)
+	// Удалить курьера
+	// (DELETE /api/v1/couriers/{courierId})
+	DeleteCourier(ctx context.Context, request DeleteCourierRequestObject) (DeleteCourierResponseObject, error)
+	// Получить курьера
+	// (GET /api/v1/couriers/{courierId})
+	GetCourier(ctx context.Context, request GetCourierRequestObject) (GetCourierResponseObject, error)
+	// Изменить курьера
+	// (PATCH /api/v1/couriers/{courierId})
+	UpdateCourier(ctx context.Context, request UpdateCourierRequestObject) (UpdateCourierResponseObject, error)
+	// Завершить смену
+	// (POST /api/v1/couriers/{courierId}/end-shift)
+	EndShift(ctx context.Context, request EndShiftRequestObject) (EndShiftResponseObject, error)
+	// Начать смену
+	// (POST /api/v1/couriers/{courierId}/start-shift)
+	StartShift(ctx context.Context, request StartShiftRequestObject) (StartShiftResponseObject, error)
+	// Добавить место хранения
+	// (POST /api/v1/couriers/{courierId}/storage-places)
+	AddStoragePlace(ctx context.Context, request AddStoragePlaceRequestObject) (AddStoragePlaceResponseObject, error)
+	// Удалить место хранения
+	// (DELETE /api/v1/couriers/{courierId}/storage-places/{storagePlaceId})
+	RemoveStoragePlace(ctx context.Context, request RemoveStoragePlaceRequestObject) (RemoveStoragePlaceResponseObject, error)
+	// Изменить объем места хранения
+	// (PATCH /api/v1/couriers/{courierId}/storage-places/{storagePlaceId})
+	ResizeStoragePlace(ctx context.Context, request ResizeStoragePlaceRequestObject) (ResizeStoragePlaceResponseObject, error)
+	// Создать заказ
+	// (POST /api/v1/orders)
+	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
+	// Получить все незавершенные заказы
+	// (GET /api/v1/orders/active)
+	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
+	// Получить заказ
+	// (GET /api/v1/orders/{orderId})
+	GetOrder(ctx context.Context, request GetOrderRequestObject) (GetOrderResponseObject, error)
+	// Отменить заказ
+	// (POST /api/v1/orders/{orderId}/cancel)
+	CancelOrder(ctx context.Context, request CancelOrderRequestObject) (CancelOrderResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetCouriers operation middleware
+func (sh *strictHandler) GetCouriers(ctx echo.Context) error {
+	var request GetCouriersRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCouriers(ctx.Request().Context(), request.(GetCouriersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCouriers")
+	}
+
+	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
@@ -369,6 +1333,226 @@ func (sh *strictHandler) CreateCourier(ctx echo.Context) error {
 	return nil
 }
 
+// DeleteCourier operation middleware
+func (sh *strictHandler) DeleteCourier(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request DeleteCourierRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCourier(ctx.Request().Context(), request.(DeleteCourierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCourier")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCourierResponseObject); ok {
+		return validResponse.VisitDeleteCourierResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCourier operation middleware
+func (sh *strictHandler) GetCourier(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request GetCourierRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCourier(ctx.Request().Context(), request.(GetCourierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCourier")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCourierResponseObject); ok {
+		return validResponse.VisitGetCourierResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateCourier operation middleware
+func (sh *strictHandler) UpdateCourier(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request UpdateCourierRequestObject
+
+	request.CourierId = courierId
+
+	var body UpdateCourierJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCourier(ctx.Request().Context(), request.(UpdateCourierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCourier")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateCourierResponseObject); ok {
+		return validResponse.VisitUpdateCourierResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EndShift operation middleware
+func (sh *strictHandler) EndShift(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request EndShiftRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EndShift(ctx.Request().Context(), request.(EndShiftRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EndShift")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EndShiftResponseObject); ok {
+		return validResponse.VisitEndShiftResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StartShift operation middleware
+func (sh *strictHandler) StartShift(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request StartShiftRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StartShift(ctx.Request().Context(), request.(StartShiftRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartShift")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StartShiftResponseObject); ok {
+		return validResponse.VisitStartShiftResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddStoragePlace operation middleware
+func (sh *strictHandler) AddStoragePlace(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request AddStoragePlaceRequestObject
+
+	request.CourierId = courierId
+
+	var body AddStoragePlaceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddStoragePlace(ctx.Request().Context(), request.(AddStoragePlaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddStoragePlace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddStoragePlaceResponseObject); ok {
+		return validResponse.VisitAddStoragePlaceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RemoveStoragePlace operation middleware
+func (sh *strictHandler) RemoveStoragePlace(ctx echo.Context, courierId openapi_types.UUID, storagePlaceId openapi_types.UUID) error {
+	var request RemoveStoragePlaceRequestObject
+
+	request.CourierId = courierId
+	request.StoragePlaceId = storagePlaceId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveStoragePlace(ctx.Request().Context(), request.(RemoveStoragePlaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveStoragePlace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RemoveStoragePlaceResponseObject); ok {
+		return validResponse.VisitRemoveStoragePlaceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ResizeStoragePlace operation middleware
+func (sh *strictHandler) ResizeStoragePlace(ctx echo.Context, courierId openapi_types.UUID, storagePlaceId openapi_types.UUID) error {
+	var request ResizeStoragePlaceRequestObject
+
+	request.CourierId = courierId
+	request.StoragePlaceId = storagePlaceId
+
+	var body ResizeStoragePlaceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ResizeStoragePlace(ctx.Request().Context(), request.(ResizeStoragePlaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResizeStoragePlace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ResizeStoragePlaceResponseObject); ok {
+		return validResponse.VisitResizeStoragePlaceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // CreateOrder operation middleware
 func (sh *strictHandler) CreateOrder(ctx echo.Context) error {
 	var request CreateOrderRequestObject
@@ -415,24 +1599,104 @@ func (sh *strictHandler) GetOrders(ctx echo.Context) error {
 	return nil
 }
 
+// GetOrder operation middleware
+func (sh *strictHandler) GetOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request GetOrderRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrder(ctx.Request().Context(), request.(GetOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderResponseObject); ok {
+		return validResponse.VisitGetOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CancelOrder operation middleware
+func (sh *strictHandler) CancelOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request CancelOrderRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelOrder(ctx.Request().Context(), request.(CancelOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelOrderResponseObject); ok {
+		return validResponse.VisitCancelOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xVT28bRRT/KqsHx1Vtt1zYIwEhpIgeuIAQh8V+caby7iyz47RWtFLsQBspUXPhgHoo",
-	"KnyBjcnKi0Pcr/DeN0IzEyduPHFcEVURF8u7O/Pm9++92YW2TDKZYqpziHYhb29jEtu/G7KvBCrzN1My",
-	"Q6UF2g+iY347mLeVyLSQKURAv9EpVXTOI6r5Z6ppSiWPaMZ7EMKWVEmsIYJ+X3QgBD3IECLItRJpF4oQ",
-	"erIdu0K78LHCLYjgo8YVsMYFqsbmfF0RQhon6MXxDx8vn1GEoPCnvlDYgeh7sDBshYXDf7jcJX98gm1t",
-	"TvlCKemRoC07vsNf0YxOA5rxAdV0QlOqF9mLVD96eAVNpBq7qMwpCeZ53PVV/IMqmvKQR9erruZn8V3V",
-	"9THbXND8XXLPlnF8a4qJVCT9BKKmj8JgedN3t2y6hvkZmCo+qF/j0xvDeEsMEpFuYtrV2xC1PMHLM0Rf",
-	"mt/Q1GSXZkZ6Plok0rqVyEWuXG0fn8eqc1/7ytcnKxrErBfplvQAf80jGlPFL6ikyuR3QmXA+/zCPU15",
-	"n/f4iCqjMo3DwPDkIb01n+2iParNHn5ONb80n60ZVNKYZjQN330z5X0jgNA9A++bp3G3iyr4HHtiB9UA",
-	"QthBlTtkrQfNB02jjswwjTMBETyyr0LIYr1trWjEmWjstBptFzv7rovaQ/N3mtHEQjrjY0ftrX0wTGsT",
-	"noDGPKSKf1kiDRaDsuJ+1YEIvkS9MT/RGJFnMs1dOB42m27wpBpTCyTOsp5wzjSe5M5kZ6gNk8Ykv833",
-	"eVcVl8bGSsUD5+s1on9emHNA53xIf5t55AwegV28Ffd7+r0grkLm5q4Px+vLMVjauOb9JInVYO7FesIX",
-	"IWQyX9PPU5rRiU3ZRdnFauWSiRsKY41zaV0/Ya4/k53BncmzMBF9Gr26AgjFUpBaHtqr3f3kPcP3n50N",
-	"aEwlnVFNp24CUO1wfPrBcfCha2g6N3OYaj4O6MSOpnMzsAI6oxn9ZSdzfW864dfVkTWr5yNOmsvIJmPt",
-	"juChfWWsscUnVBoQNAl4GPBzquiMj/hlwCOjkL26bNtR6QS8oWXctXgHcb0XFry5QSOP+I24rcUO3sEl",
-	"E9iUTqzzFe/xgc2s0ahagMCHvpvnsQvCh7h3nNP/61tnbSeKoij+DQAA//8JDQamhg0AAA==",
+	"H4sIAAAAAAAC/+xbX2/byBH/KsS2j0xk3wVF4rdLcggCBE1wvvZaHPLASGuZV4nkkaskriHAkuokqIMz",
+	"cL0iQZCkSA8o+ig74pmxZPorzH6jYmdJiiKX+uMoidwTEDiiRO7Ozp/f/GZ2uU3Kdt2xLWoxj6xtE6+8",
+	"SesGfvyiUnGphx8d13aoy0yKV4ZjuKxOLSYuKtQru6bDTNsiawRewCF0+Q5vQ8B3oEt0wrYcStaIx1zT",
+	"qpKmTsom21I8+Q8I+Q6E0FM+Yzcs5qoee8PbYiI4UU+2aTc8qnjsJwhhoHrAYy6lqpX9DH0I+CPVNE2d",
+	"uPT7hunSCln7NhE2WmoyZiyNntLg3WQw+953tMyECNfshmtSN694s6KQ6zn0wIcT1PjfIIBj6PK20CXR",
+	"yYbt1g1G1kijYVZUq63ZZUMOtE1+69INskZ+Uxp6RClyh9Kt+L6mTiyjTpVyDPi+WqMGa3iTZogWvS5v",
+	"buqEuYblObbLJj35dXJj1hC4ZhQ3tdJEoPQUY8xwnTLDrHmLao268fCOsVWzDZU0L6ELx7wFAQygC33+",
+	"FE74HrzT4BB83tLgSPwOXTiCEA418e8tBtNA3M93dW1Fu6DBAfhwpEEY/XgCAX8sFglB2uCmxWiVumfx",
+	"EKdwAT8WyTkQP/C2kFLju5FckUz5hSil9BxKVXO+gWMJRWIC/lT97Nl82mO2a1TpnZpRpp5i6pfxqnJr",
+	"IjoxGa1PnHI9NQNpJqIbrmtsfYCoUoRSrNgR1xzaOBOJaX2MCcL1RN95+BcRxjvCTY55h+/wp+BHqYda",
+	"jbqQ+PbGxvUGIvFta33T3GD46apLjb8QnVynRpmZ9w1GK+Suwju/dF1bAcZlu0KVCTCEngYhfwIBHMAx",
+	"BOnINy32+WdKj6pTzzOqqhH/Db4IYt7OjjopC1UoGY6rUu6tFN6MLq5mMJM1lAv8L2b2EL30mxvrly/p",
+	"mkA4RJdjCEXw8R3w4RdEHV9eJODDdzVxFwZYDwKRtnk7raGK3bhXo9J7zLqw35UVndRNS15cuLKSLMRq",
+	"1O9J5dVsq1okr8j0fXj7MSVevTwi8upllcwP87L+iaQeW1F5iYIB/XnCQxm3eEjEKCpv+D19UMg8PnGW",
+	"0TU4hVDjHRig3R5HN/wgBz+EQEwvI6StxYyQt8RTSEa7EzU7IWfVTesWtapsk6ytqjjOdLlkvutYVa1j",
+	"DgCPqijwkJH8knOTDdeomjW6bmyodPkvAY18V/yVi+xDoEEP+nwf8x3vwKmANr4r4xMrCfH/EOvu2XaN",
+	"GjHx+Yaa1U12Bo88G9E5kwO9Qsc/lMOBP9mVXLrhmlXqYj7Kj/dP6UmR8kK+C33owi+SdkYz5JXFbGbU",
+	"/mjXGkoZX8MB/zv4MEgvcnUilEQcID22ymtuuxUVpBjD8nKcm8ZVaFNfCMKt4kIpQhMvqlAPhdXEIqgj",
+	"YZg5FAslLYVDCVQSzbvTklJc+U1G6ypGepaix5uCDqoWiK56xzXLhVHA9+EQ+hhLItHva/xRdBHGgXco",
+	"+cAp+PAOjqPyIs3wfndJyfAeFKHVj2NBSZQAAUKIrA18OCETQxPtm5BzhYPGlhvRSSJjofuiEXO+W7Xt",
+	"ys2ZvDEN8N1pXNMpMNp/0DpddEsN/IihBfwR75zdUt83DIupu1QvkE1iXkBrHEKoHIKZrFaUDpBlwolU",
+	"QBa0C9h8pOJ44FghKVlVNvuKeuZf6fic/UFSw6ScsDg0Yv4o+vGJyXuQkZz0drnccEwl9XgmnuP7QhOR",
+	"0uMuTDhK8gdKTdsIILPBRDrbjPR9fFWHZKJpPjG3mga0FaQqZZTMEvSRUEm7nirovk6XBtkmQ7bUyHRT",
+	"dGmKHnTBF6VJK1fYDAp7V5rQpvCLE74nAjUhE1EfI27T3KEVKmxlWEQnV83yVhmRbr1s24y6RCfXDFfZ",
+	"ofmDUzEYXdTidTFKz8n4nfEX8ZVpbdgqn8bE5/PHkTdgdPIOJsWM44RwqAub++hZPm/jTTvIaLr8kax9",
+	"0y4RwrGecRLeSfLeGll/YFSr1NWu05p5n+Iuy33qelKy1YsrF1cQaxxqGY5J1sjn+JVOHINtojeUDMcs",
+	"3V8tlaW7SAJDWUGiOUKR+nxfLu0UL8RKg4gM8hb4fDe3aIIyuEi7BOaRG5Rdi2cUUew5tuVJ//xsZYVg",
+	"Q9Fi0aaa4Tg1U3K20nee5MaSB4tPU3HuaLI84xZ2zW5tRcZ5Evt+GBm4TfDmDaNRYzOJOE4y2U9VyfE6",
+	"aW920SO9Rr1uuFuxLaZTvCCLtjelPXsQwkHUecFhsy3kUSNec2kKaCR6U49dtStbc1NPqg2n0tGLoYCk",
+	"mXOkVdXG5VjrXlpZmZvoU1lWQ/7RhwB6EgEgkHJc+ehy8D0Z0OlUdYDQdCLBvy8QHQlJsDCR8NN4lxV3",
+	"ZyGutB19ullpSgepUabulfuYDtsQ4PiCS/sKLiCKq2P0I7ln/1YwwJMkFwwT5kUNWeOpaGwKWWNUF4mg",
+	"xfdj2o4/y8ECCDJj5KLwOoo/jELHcI06ZYjl385CLrPBbooHRKKIedgaSRRH0lyNuQ2qp4w8gXg27+YC",
+	"9dI5DtRLH0GOFMyhZ4k/XXgnbbnEixnw4mfoSTMq0UJ/D+4zMpTGW6kaAAYQ5OoAXcOiHI6GdaTkpoID",
+	"Brmagv8whkSd07CfXwhnTqfMTusWJI4Xk13mosQxWHlzujgReDCIfB4HC0Qhp/LwSXRztK49Nx4/f048",
+	"qgiVcZ/HShdWgAHfAz9GrP3ciprquFym42U6/uBQ8zyLDrPR9xK1Khc8PMO0tl1U6KatxTupVjlvidMH",
+	"rShS9uRxBNSmYPRCjYVMPgdOX1qV+CzVuc3Ey4hfRvwHj/hnWKz7fIc/iWI+icDO5Hj3mOGymSJeqCkV",
+	"87g9OpwRm/Fia/uxPMuW5fZdlHAY+3wvF/nrQqRl7C9jfxn7k2L/FXSTmJot6nF//IKTnBSfvqctNNWL",
+	"5kxHGe/IfnesxQAPk+COF4TgD6v3UFW5Cw84xf7cQPqF6EG+xe1RsTV6nEOJLyqVkV3+X3H5kj03qXKi",
+	"l4XKn6J8WV0C2hLQPs3uQzFozIpype306yCTtirywMc7o53OU96Rkk3ENnHkoYWn/nr4/cj92TZnZpaT",
+	"CEOP+H4OAr+idfs+PXcoqM8i1WDsK0t5EUdtvNxV+XQ4GGTPcOXP7WShku8twfLsWy/joPL9OsxhfMgs",
+	"jXrYyhl3Kmt881lxdnQJXnMGr/lTTYXVVE77SrT0Ijgc+s5YdSxb6EvsPc999un8PM1Z8cDybMW3aLDD",
+	"EfRybTSxPY6vkvRxu5u3IxECeWoNukmcqU6cyVeo5lD2LYRp3hToSKH8Er6aTedwRlMy9aNULxZf65Eb",
+	"heO6nTcouy0d4WMc25SW/r8+tDm1JRTusB29RNB8P48YDcvWyPHjQfIuSUsKKGBNk70vDTW+g4WiaOz5",
+	"hd7yPlwp84ZdnoZESljYsykjbzou6smUZ4kPnKNzKWOxMgmOUtmwyrQ2W9tYmGAkYSZzpbaJ0zxeS20X",
+	"y7sPMJn280kMxTnXcbEktmcNpCVjnTLYXxcGoBip+b8BAKh534aqTQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,42 @@
+package jobs
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+
+	"github.com/labstack/gommon/log"
+	"github.com/robfig/cron/v3"
+)
+
+var _ cron.Job = &AssignOrdersBatchJob{}
+
+type AssignOrdersBatchJob struct {
+	assignOrdersBatchHandler commands.AssignOrdersBatchHandler
+}
+
+func NewAssignOrdersBatchJob(assignOrdersBatchHandler commands.AssignOrdersBatchHandler) (cron.Job, error) {
+	if assignOrdersBatchHandler == nil {
+		return nil, errs.NewValueIsInvalidError("assignOrdersBatchHandler")
+	}
+	return &AssignOrdersBatchJob{assignOrdersBatchHandler: assignOrdersBatchHandler}, nil
+}
+
+func (j *AssignOrdersBatchJob) Run() {
+	ctx := context.Background()
+	command := commands.NewAssignOrdersBatchCommand()
+	result, err := j.assignOrdersBatchHandler.Handle(ctx, command)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if result.Assigned == 0 && len(result.Unassigned) == 0 {
+		return
+	}
+
+	reasons := make(map[string]int)
+	for _, unassigned := range result.Unassigned {
+		reasons[unassigned.Reason]++
+	}
+	log.Infof("Assigned %d orders, %d left unassigned: %v", result.Assigned, len(result.Unassigned), reasons)
+}
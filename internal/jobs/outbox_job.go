@@ -0,0 +1,76 @@
+package jobs
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/outbox"
+	"time"
+
+	"github.com/labstack/gommon/log"
+	"github.com/robfig/cron/v3"
+)
+
+var _ cron.Job = &OutboxJob{}
+
+// OutboxJob relays messages stored by UnitOfWork to the message broker
+type OutboxJob struct {
+	outboxRepository ports.OutboxRepository
+	eventRegistry    outbox.EventRegistry
+	orderProducer    ports.OrderProducer
+}
+
+func NewOutboxJob(
+	outboxRepository ports.OutboxRepository,
+	eventRegistry outbox.EventRegistry,
+	orderProducer ports.OrderProducer,
+) (cron.Job, error) {
+	if outboxRepository == nil {
+		return nil, errs.NewValueIsInvalidError("outboxRepository")
+	}
+	if eventRegistry == nil {
+		return nil, errs.NewValueIsInvalidError("eventRegistry")
+	}
+	if orderProducer == nil {
+		return nil, errs.NewValueIsInvalidError("orderProducer")
+	}
+
+	return &OutboxJob{
+		outboxRepository: outboxRepository,
+		eventRegistry:    eventRegistry,
+		orderProducer:    orderProducer,
+	}, nil
+}
+
+func (j *OutboxJob) Run() {
+	ctx := context.Background()
+
+	messages, err := j.outboxRepository.GetNotPublishedMessages(ctx)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	for _, message := range messages {
+		domainEvent, err := j.eventRegistry.DecodeDomainEvent(message)
+		if err != nil {
+			log.Error(err)
+			return
+		}
+
+		// Stop on the first failure to keep messages in order
+		err = j.orderProducer.Publish(ctx, domainEvent)
+		if err != nil {
+			log.Error(err)
+			return
+		}
+
+		processedAt := time.Now().UTC()
+		message.ProcessedAtUtc = &processedAt
+		err = j.outboxRepository.Update(ctx, message)
+		if err != nil {
+			log.Error(err)
+			return
+		}
+	}
+}
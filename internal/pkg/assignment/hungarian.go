@@ -0,0 +1,128 @@
+// Package assignment solves the assignment problem
+package assignment
+
+import "math"
+
+// Forbidden marks a pair that can not be matched
+var Forbidden = math.Inf(1)
+
+// MinCost matches rows to columns so that the total cost is minimal, using the
+// Hungarian algorithm in O(n^2*m). costs must be rectangular. Each row and column
+// is used at most once. The result holds a column index for every row, or -1 if
+// the row is left unmatched because there are fewer columns or all its pairs are Forbidden
+func MinCost(costs [][]float64) []int {
+	rows := len(costs)
+	if rows == 0 {
+		return []int{}
+	}
+	cols := len(costs[0])
+
+	result := make([]int, rows)
+	for i := range result {
+		result[i] = -1
+	}
+	if cols == 0 {
+		return result
+	}
+
+	// The algorithm needs rows <= cols, otherwise solve the transposed problem
+	if rows > cols {
+		transposed := make([][]float64, cols)
+		for j := range transposed {
+			transposed[j] = make([]float64, rows)
+			for i := range costs {
+				transposed[j][i] = costs[i][j]
+			}
+		}
+		for j, i := range MinCost(transposed) {
+			if i >= 0 {
+				result[i] = j
+			}
+		}
+		return result
+	}
+
+	// Forbidden pairs get a cost higher than any complete feasible matching
+	// and are dropped from the result afterwards
+	forbiddenCost := 1.0
+	for i := range costs {
+		for j := range costs[i] {
+			if !math.IsInf(costs[i][j], 1) {
+				forbiddenCost += math.Abs(costs[i][j])
+			}
+		}
+	}
+	cost := func(i, j int) float64 {
+		if math.IsInf(costs[i][j], 1) {
+			return forbiddenCost
+		}
+		return costs[i][j]
+	}
+
+	// Potentials u (rows) and v (columns), p[j] is the row matched to column j,
+	// all indexes are 1-based, 0 is a fictive column
+	u := make([]float64, rows+1)
+	v := make([]float64, cols+1)
+	p := make([]int, cols+1)
+	way := make([]int, cols+1)
+	for i := 1; i <= rows; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]float64, cols+1)
+		used := make([]bool, cols+1)
+		for j := range minv {
+			minv[j] = math.Inf(1)
+		}
+		for {
+			used[j0] = true
+			i0 := p[j0]
+			delta := math.Inf(1)
+			j1 := 0
+			for j := 1; j <= cols; j++ {
+				if used[j] {
+					continue
+				}
+				current := cost(i0-1, j-1) - u[i0] - v[j]
+				if current < minv[j] {
+					minv[j] = current
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= cols; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+		for {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+			if j0 == 0 {
+				break
+			}
+		}
+	}
+
+	for j := 1; j <= cols; j++ {
+		if p[j] == 0 {
+			continue
+		}
+		i := p[j] - 1
+		if !math.IsInf(costs[i][j-1], 1) {
+			result[i] = j - 1
+		}
+	}
+	return result
+}
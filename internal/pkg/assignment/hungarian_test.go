@@ -0,0 +1,132 @@
+package assignment_test
+
+import (
+	"delivery/internal/pkg/assignment"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var f = assignment.Forbidden
+
+func Test_MinCost(t *testing.T) {
+	tests := map[string]struct {
+		costs    [][]float64
+		expected []int
+	}{
+		"no_rows": {
+			costs:    [][]float64{},
+			expected: []int{},
+		},
+		"no_columns": {
+			costs:    [][]float64{{}, {}},
+			expected: []int{-1, -1},
+		},
+		"square": {
+			costs: [][]float64{
+				{4, 1, 3},
+				{2, 0, 5},
+				{3, 2, 2},
+			},
+			expected: []int{1, 0, 2},
+		},
+		"optimal_differs_from_greedy": {
+			// Greedy takes the cheapest pair 0-0 first and pays 1+100, the optimum is 2+2
+			costs: [][]float64{
+				{1, 2},
+				{2, 100},
+			},
+			expected: []int{1, 0},
+		},
+		"more_rows_than_columns": {
+			costs: [][]float64{
+				{5, 1},
+				{1, 5},
+				{3, 3},
+			},
+			expected: []int{1, 0, -1},
+		},
+		"more_columns_than_rows": {
+			costs: [][]float64{
+				{3, 2, 9},
+				{1, 4, 9},
+			},
+			expected: []int{1, 0},
+		},
+		"all_forbidden_row": {
+			costs: [][]float64{
+				{f, f},
+				{1, 2},
+			},
+			expected: []int{-1, 0},
+		},
+		"all_forbidden": {
+			costs: [][]float64{
+				{f, f},
+				{f, f},
+			},
+			expected: []int{-1, -1},
+		},
+		"forbidden_pair_is_avoided": {
+			costs: [][]float64{
+				{1, f},
+				{2, 100},
+			},
+			expected: []int{0, 1},
+		},
+		"forbidden_in_transposed_problem": {
+			costs: [][]float64{
+				{f},
+				{7},
+				{3},
+			},
+			expected: []int{-1, -1, 0},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			result := assignment.MinCost(test.costs)
+
+			// Assert
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func Test_MinCostMatchesBruteForce(t *testing.T) {
+	// Arrange
+	costs := [][]float64{
+		{7, 3, 8, 2},
+		{6, 9, 4, 7},
+		{5, 8, 3, 9},
+		{4, 2, 6, 5},
+	}
+
+	// Act
+	result := assignment.MinCost(costs)
+
+	// Assert
+	total := 0.0
+	for i, j := range result {
+		total += costs[i][j]
+	}
+	assert.Equal(t, bruteForceMinCost(costs, 0, make([]bool, len(costs[0]))), total)
+}
+
+func bruteForceMinCost(costs [][]float64, row int, used []bool) float64 {
+	if row == len(costs) {
+		return 0
+	}
+	best := f
+	for j := range costs[row] {
+		if used[j] {
+			continue
+		}
+		used[j] = true
+		best = min(best, costs[row][j]+bruteForceMinCost(costs, row+1, used))
+		used[j] = false
+	}
+	return best
+}
@@ -2,6 +2,7 @@ package ddd
 
 type BaseAggregate[ID comparable] struct {
 	baseEntity   *BaseEntity[ID]
+	version      int64
 	domainEvents []DomainEvent
 }
 
@@ -12,10 +13,26 @@ func NewBaseAggregate[ID comparable](id ID) *BaseAggregate[ID] {
 	}
 }
 
+// RestoreBaseAggregate is used by repositories to rebuild an aggregate with its stored version
+func RestoreBaseAggregate[ID comparable](id ID, version int64) *BaseAggregate[ID] {
+	ba := NewBaseAggregate(id)
+	ba.version = version
+	return ba
+}
+
 func (ba *BaseAggregate[ID]) ID() ID {
 	return ba.baseEntity.ID()
 }
 
+// Version is used for optimistic concurrency control
+func (ba *BaseAggregate[ID]) Version() int64 {
+	return ba.version
+}
+
+func (ba *BaseAggregate[ID]) SetVersion(version int64) {
+	ba.version = version
+}
+
 func (ba *BaseAggregate[ID]) Equal(other *BaseAggregate[ID]) bool {
 	if other == nil {
 		return false
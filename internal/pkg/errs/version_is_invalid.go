@@ -12,14 +12,14 @@ type VersionIsInvalidError struct {
 	Cause     error
 }
 
-func NewVersionIsInvalidError(paramName string, cause error) *VersionIsInvalidError {
+func NewVersionIsInvalidErrorWithCause(paramName string, cause error) *VersionIsInvalidError {
 	return &VersionIsInvalidError{
 		ParamName: paramName,
 		Cause:     cause,
 	}
 }
 
-func NewVersionIsInvalidErrorWithCause(paramName string) *VersionIsInvalidError {
+func NewVersionIsInvalidError(paramName string) *VersionIsInvalidError {
 	return &VersionIsInvalidError{
 		ParamName: paramName,
 	}
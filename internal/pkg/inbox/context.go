@@ -0,0 +1,16 @@
+package inbox
+
+import "context"
+
+type contextKey struct{}
+
+// WithMessage marks the context as handling the message, so that the unit of work
+// records it on commit. Commands do not need to know about it
+func WithMessage(ctx context.Context, message Message) context.Context {
+	return context.WithValue(ctx, contextKey{}, message)
+}
+
+func MessageFromContext(ctx context.Context) (Message, bool) {
+	message, ok := ctx.Value(contextKey{}).(Message)
+	return message, ok
+}
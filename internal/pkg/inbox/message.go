@@ -0,0 +1,40 @@
+package inbox
+
+import (
+	"delivery/internal/pkg/errs"
+	"errors"
+	"time"
+)
+
+// ErrAlreadyProcessed is returned on commit when the message has been handled before,
+// the changes made for it are rolled back
+var ErrAlreadyProcessed = errors.New("message is already processed")
+
+// Message is a record of an integration event which has been handled.
+// It is stored in the same transaction as the changes made for the event
+type Message struct {
+	Topic          string `gorm:"primaryKey"`
+	EventID        string `gorm:"primaryKey"`
+	Partition      int32
+	Offset         int64
+	ProcessedAtUtc time.Time
+}
+
+func NewMessage(topic string, partition int32, offset int64, eventID string) (Message, error) {
+	if topic == "" {
+		return Message{}, errs.NewValueIsRequiredError("topic")
+	}
+	if eventID == "" {
+		return Message{}, errs.NewValueIsRequiredError("eventID")
+	}
+	return Message{
+		Topic:     topic,
+		EventID:   eventID,
+		Partition: partition,
+		Offset:    offset,
+	}, nil
+}
+
+func (Message) TableName() string {
+	return "inbox"
+}
@@ -11,6 +11,7 @@ import (
 
 type EventRegistry interface {
 	RegisterDomainEvent(eventType reflect.Type) error
+	IsRegistered(domainEvent ddd.DomainEvent) bool
 	DecodeDomainEvent(event *Message) (ddd.DomainEvent, error)
 }
 
@@ -35,6 +36,12 @@ func (r *eventRegistry) RegisterDomainEvent(eventType reflect.Type) error {
 	return nil
 }
 
+// IsRegistered reports whether the event is published outside the service and so goes to the outbox
+func (r *eventRegistry) IsRegistered(domainEvent ddd.DomainEvent) bool {
+	_, ok := r.EventRegistry[domainEvent.GetName()]
+	return ok
+}
+
 func EncodeDomainEvent(domainEvent ddd.DomainEvent) (Message, error) {
 	payload, err := json.Marshal(domainEvent)
 	if err != nil {
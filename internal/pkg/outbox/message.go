@@ -6,11 +6,11 @@ import (
 )
 
 type Message struct {
-	ID             uuid.UUID
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey"`
 	Name           string
 	Payload        []byte
 	OccurredAtUtc  time.Time
-	ProcessedAtUtc *time.Time
+	ProcessedAtUtc *time.Time `gorm:"index"`
 }
 
 func (Message) TableName() string {